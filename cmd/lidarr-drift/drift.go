@@ -0,0 +1,263 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/terraform-provider-lidarr/internal/provider"
+)
+
+// resourcePrefix is the prefix of the provider resource types.
+const resourcePrefix = "lidarr_"
+
+// state is the subset of the Terraform state file needed to detect drift.
+type state struct {
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   any            `json:"index_key"`
+			Attributes map[string]any `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// Resource identifies a Terraform resource or a Lidarr object.
+type Resource struct {
+	Address string `json:"address"`
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+}
+
+// Change describes an attribute changed outside Terraform.
+type Change struct {
+	Attribute string `json:"attribute"`
+	State     any    `json:"state"`
+	Live      any    `json:"live"`
+}
+
+// ChangedResource describes a resource changed outside Terraform.
+type ChangedResource struct {
+	Resource
+	Changes []Change `json:"changes"`
+}
+
+// Report describes the drift between Terraform state and the live instance.
+// Unchecked lists the managed resources of types not read from Lidarr, whose drift is unknown.
+type Report struct {
+	Changed   []ChangedResource `json:"changed"`
+	Missing   []Resource        `json:"missing"`
+	Unmanaged []Resource        `json:"unmanaged"`
+	Unchecked []Resource        `json:"unchecked"`
+}
+
+// HasDrift returns true if any drift was detected.
+func (r Report) HasDrift() bool {
+	return len(r.Changed)+len(r.Missing)+len(r.Unmanaged) > 0
+}
+
+// parseState decodes a Terraform state file.
+func parseState(reader io.Reader) (*state, error) {
+	var s state
+	if err := json.NewDecoder(reader).Decode(&s); err != nil {
+		return nil, fmt.Errorf("unable to parse state: %w", err)
+	}
+
+	return &s, nil
+}
+
+// compare builds the drift report between state and live resources.
+func compare(s *state, live []provider.ExportedResource) Report {
+	report := Report{
+		Changed:   []ChangedResource{},
+		Missing:   []Resource{},
+		Unmanaged: []Resource{},
+		Unchecked: []Resource{},
+	}
+
+	liveByKey := make(map[string]provider.ExportedResource, len(live))
+	for _, r := range live {
+		liveByKey[r.Type+"/"+r.ID] = r
+	}
+
+	managed := make(map[string]bool)
+
+	for _, r := range s.Resources {
+		if r.Mode != "managed" || !strings.HasPrefix(r.Type, resourcePrefix) {
+			continue
+		}
+
+		exportedType, exported := provider.ExportedType(r.Type)
+
+		for _, instance := range r.Instances {
+			resource := Resource{
+				Address: address(r.Module, r.Type, r.Name, instance.IndexKey),
+				Type:    r.Type,
+				ID:      fmt.Sprint(formatValue(instance.Attributes["id"])),
+			}
+
+			if !exported {
+				report.Unchecked = append(report.Unchecked, resource)
+
+				continue
+			}
+
+			key := exportedType + "/" + resource.ID
+			managed[key] = true
+
+			liveResource, found := liveByKey[key]
+			if !found {
+				report.Missing = append(report.Missing, resource)
+
+				continue
+			}
+
+			if changes := diff(instance.Attributes, liveResource.Values); len(changes) > 0 {
+				report.Changed = append(report.Changed, ChangedResource{Resource: resource, Changes: changes})
+			}
+		}
+	}
+
+	for _, r := range live {
+		if !managed[r.Type+"/"+r.ID] {
+			report.Unmanaged = append(report.Unmanaged, Resource{
+				Address: r.Type + "." + r.Name,
+				Type:    r.Type,
+				ID:      r.ID,
+			})
+		}
+	}
+
+	return report
+}
+
+// diff compares the attributes known by both state and live resource.
+// Live null values are skipped, since they are not returned by the API (e.g. create only options).
+func diff(stateValues, liveValues map[string]any) []Change {
+	var changes []Change
+
+	for name, stateValue := range stateValues {
+		liveValue, ok := liveValues[name]
+		if !ok || liveValue == nil {
+			continue
+		}
+
+		if !reflect.DeepEqual(normalize(stateValue), normalize(liveValue)) {
+			changes = append(changes, Change{
+				Attribute: name,
+				State:     formatValue(stateValue),
+				Live:      formatValue(liveValue),
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Attribute < changes[j].Attribute })
+
+	return changes
+}
+
+// normalize sorts lists, since sets have no stable order.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case []any:
+		output := make([]any, len(v))
+		for i := range v {
+			output[i] = normalize(v[i])
+		}
+
+		sort.Slice(output, func(i, j int) bool {
+			return fmt.Sprintf("%#v", output[i]) < fmt.Sprintf("%#v", output[j])
+		})
+
+		return output
+	case map[string]any:
+		output := make(map[string]any, len(v))
+		for key := range v {
+			output[key] = normalize(v[key])
+		}
+
+		return output
+	default:
+		return v
+	}
+}
+
+// formatValue renders integral numbers without decimals.
+func formatValue(value any) any {
+	if f, ok := value.(float64); ok && f == float64(int64(f)) {
+		return int64(f)
+	}
+
+	return value
+}
+
+func address(module, resourceType, name string, indexKey any) string {
+	output := resourceType + "." + name
+	if module != "" {
+		output = module + "." + output
+	}
+
+	switch key := indexKey.(type) {
+	case nil:
+		return output
+	case string:
+		return output + "[" + strconv.Quote(key) + "]"
+	default:
+		return fmt.Sprintf("%s[%v]", output, formatValue(key))
+	}
+}
+
+// writeText renders the report in a human readable form.
+func writeText(w io.Writer, report Report) {
+	if !report.HasDrift() && len(report.Unchecked) == 0 {
+		fmt.Fprintln(w, "No drift detected.")
+
+		return
+	}
+
+	for _, r := range report.Changed {
+		fmt.Fprintf(w, "~ %s (id %s) changed outside Terraform\n", r.Address, r.ID)
+
+		for _, c := range r.Changes {
+			fmt.Fprintf(w, "    %s: %s => %s\n", c.Attribute, jsonString(c.State), jsonString(c.Live))
+		}
+	}
+
+	for _, r := range report.Missing {
+		fmt.Fprintf(w, "- %s (id %s) not found in Lidarr\n", r.Address, r.ID)
+	}
+
+	for _, r := range report.Unmanaged {
+		fmt.Fprintf(w, "+ %s (id %s) not managed by Terraform\n", r.Address, r.ID)
+	}
+
+	for _, r := range report.Unchecked {
+		fmt.Fprintf(w, "? %s (id %s) not checked, %s is not supported\n", r.Address, r.ID, r.Type)
+	}
+
+	fmt.Fprintf(w, "\n%d changed, %d missing, %d unmanaged, %d unchecked.\n", len(report.Changed), len(report.Missing), len(report.Unmanaged), len(report.Unchecked))
+}
+
+// writeJSON renders the report as JSON.
+func writeJSON(w io.Writer, report Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+func jsonString(value any) string {
+	output, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return strings.TrimSpace(string(output))
+}
@@ -0,0 +1,123 @@
+// Command lidarr-drift compares a Terraform state file with the live Lidarr
+// instance, reporting changes made outside Terraform and unmanaged objects.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/provider"
+)
+
+const (
+	// exitDrift is the exit code used when drift is detected.
+	exitDrift = 2
+	// exitUnchecked is the exit code used when no drift is detected, but some resources could not be checked.
+	exitUnchecked = 3
+)
+
+var (
+	errDrift     = errors.New("drift detected")
+	errUnchecked = errors.New("resources not checked")
+)
+
+func main() {
+	err := run(context.Background(), os.Args[1:], os.Stdout, os.Stderr)
+
+	switch {
+	case errors.Is(err, errDrift):
+		os.Exit(exitDrift)
+	case errors.Is(err, errUnchecked):
+		os.Exit(exitUnchecked)
+	case err != nil:
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("lidarr-drift", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	apiURL := flags.String("url", os.Getenv("LIDARR_URL"), "Lidarr URL (e.g. http://localhost:8686), defaults to LIDARR_URL")
+	key := flags.String("api-key", os.Getenv("LIDARR_API_KEY"), "Lidarr API key, defaults to LIDARR_API_KEY")
+	statePath := flags.String("state", "terraform.tfstate", "Terraform state file, use - for stdin (e.g. terraform state pull | lidarr-drift -state -)")
+	format := flags.String("format", "text", "output format, text or json")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("invalid format %q", *format)
+	}
+
+	parsedURL, err := url.Parse(*apiURL)
+	if err != nil || parsedURL.Host == "" {
+		return fmt.Errorf("invalid URL %q", *apiURL)
+	}
+
+	if *key == "" {
+		return errors.New("API key cannot be an empty string")
+	}
+
+	s, err := readState(*statePath)
+	if err != nil {
+		return err
+	}
+
+	config := lidarr.NewConfiguration()
+	config.HTTPClient = helpers.NewRetryableHTTPClient(&http.Client{Timeout: time.Minute}, 3, time.Second, 30*time.Second)
+
+	live, diags := provider.Export(ctx, lidarr.NewAPIClient(config), helpers.NewAuthContext(ctx, parsedURL, *key))
+	for _, d := range diags {
+		fmt.Fprintf(stderr, "%s: %s\n", d.Summary(), d.Detail())
+	}
+
+	if diags.HasError() {
+		return errors.New("unable to read Lidarr")
+	}
+
+	report := compare(s, live)
+
+	if *format == "json" {
+		if err := writeJSON(stdout, report); err != nil {
+			return err
+		}
+	} else {
+		writeText(stdout, report)
+	}
+
+	if report.HasDrift() {
+		return errDrift
+	}
+
+	if len(report.Unchecked) > 0 {
+		return errUnchecked
+	}
+
+	return nil
+}
+
+func readState(path string) (*state, error) {
+	if path == "-" {
+		return parseState(os.Stdin)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return parseState(file)
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testResponses = map[string]string{
+	"/api/v1/tag": `[{"id":1,"label":"FLAC"},{"id":2,"label":"mp3"}]`,
+	"/api/v1/notification": `[{"id":3,"name":"Discord","implementation":"Discord","configContract":"DiscordSettings","onGrab":true,"tags":[2,1],
+		"fields":[{"name":"webHookUrl","value":"https://discord.com/webhook"},{"name":"username","value":"lidarr"}]}]`,
+	"/api/v1/downloadclient": `[{"id":4,"name":"Transmission","implementation":"Transmission","configContract":"TransmissionSettings","protocol":"torrent","enable":true,"priority":1,"tags":[],
+		"fields":[{"name":"host","value":"transmission"},{"name":"password","value":"********","privacy":"password"}]}]`,
+}
+
+const testState = `{
+	"version": 4,
+	"resources": [
+		{"mode": "managed", "type": "lidarr_tag", "name": "flac", "instances": [{"attributes": {"id": 1, "label": "flac"}}]},
+		{"mode": "managed", "type": "lidarr_tag", "name": "mp3", "instances": [{"attributes": {"id": 2, "label": "mp3"}}]},
+		{"module": "module.music", "mode": "managed", "type": "lidarr_tag", "name": "gone", "instances": [{"index_key": "a", "attributes": {"id": 9, "label": "gone"}}]},
+		{"mode": "managed", "type": "lidarr_notification_discord", "name": "discord", "instances": [{"attributes": {
+			"id": 3, "name": "Discord", "on_grab": true, "tags": [1, 2], "web_hook_url": "https://discord.com/webhook", "username": "lidarr", "avatar": null
+		}}]},
+		{"mode": "managed", "type": "lidarr_indexer_config", "name": "config", "instances": [{"attributes": {"id": 1}}]},
+		{"mode": "data", "type": "lidarr_tag", "name": "data", "instances": [{"attributes": {"id": 5}}]},
+		{"mode": "managed", "type": "null_resource", "name": "other", "instances": [{"attributes": {"id": "1"}}]}
+	]
+}`
+
+func testServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if response, ok := testResponses[r.URL.Path]; ok {
+			_, _ = w.Write([]byte(response))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func testStateFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "terraform.tfstate")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestRunText(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+	stdout := &bytes.Buffer{}
+
+	err := run(context.Background(), []string{"-url", server.URL, "-api-key", "key", "-state", testStateFile(t, testState)}, stdout, &bytes.Buffer{})
+	assert.ErrorIs(t, err, errDrift)
+	assert.Equal(t, `~ lidarr_tag.flac (id 1) changed outside Terraform
+    label: "flac" => "FLAC"
+- module.music.lidarr_tag.gone["a"] (id 9) not found in Lidarr
++ lidarr_download_client.transmission (id 4) not managed by Terraform
+? lidarr_indexer_config.config (id 1) not checked, lidarr_indexer_config is not supported
+
+1 changed, 1 missing, 1 unmanaged, 1 unchecked.
+`, stdout.String())
+}
+
+func TestRunJSON(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+	stdout := &bytes.Buffer{}
+
+	err := run(context.Background(), []string{"-url", server.URL, "-api-key", "key", "-state", testStateFile(t, testState), "-format", "json"}, stdout, &bytes.Buffer{})
+	assert.ErrorIs(t, err, errDrift)
+
+	var report Report
+
+	assert.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+	assert.Equal(t, Report{
+		Changed: []ChangedResource{{
+			Resource: Resource{Address: "lidarr_tag.flac", Type: "lidarr_tag", ID: "1"},
+			Changes:  []Change{{Attribute: "label", State: "flac", Live: "FLAC"}},
+		}},
+		Missing:   []Resource{{Address: `module.music.lidarr_tag.gone["a"]`, Type: "lidarr_tag", ID: "9"}},
+		Unmanaged: []Resource{{Address: "lidarr_download_client.transmission", Type: "lidarr_download_client", ID: "4"}},
+		Unchecked: []Resource{{Address: "lidarr_indexer_config.config", Type: "lidarr_indexer_config", ID: "1"}},
+	}, report)
+}
+
+func TestRunNoDrift(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+	stdout := &bytes.Buffer{}
+	state := `{"version": 4, "resources": [
+		{"mode": "managed", "type": "lidarr_tag", "name": "flac", "instances": [{"attributes": {"id": 1, "label": "FLAC"}}]},
+		{"mode": "managed", "type": "lidarr_tag", "name": "mp3", "instances": [{"attributes": {"id": 2, "label": "mp3"}}]},
+		{"mode": "managed", "type": "lidarr_notification", "name": "discord", "instances": [{"attributes": {"id": 3, "name": "Discord"}}]},
+		{"mode": "managed", "type": "lidarr_download_client_transmission", "name": "transmission", "instances": [{"attributes": {"id": 4, "host": "transmission", "password": "secret"}}]}
+	]}`
+
+	assert.NoError(t, run(context.Background(), []string{"-url", server.URL, "-api-key", "key", "-state", testStateFile(t, state)}, stdout, &bytes.Buffer{}))
+	assert.Equal(t, "No drift detected.\n", stdout.String())
+}
+
+func TestRunUnchecked(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+	stdout := &bytes.Buffer{}
+	state := `{"version": 4, "resources": [
+		{"mode": "managed", "type": "lidarr_tag", "name": "flac", "instances": [{"attributes": {"id": 1, "label": "FLAC"}}]},
+		{"mode": "managed", "type": "lidarr_tag", "name": "mp3", "instances": [{"attributes": {"id": 2, "label": "mp3"}}]},
+		{"mode": "managed", "type": "lidarr_notification", "name": "discord", "instances": [{"attributes": {"id": 3, "name": "Discord"}}]},
+		{"mode": "managed", "type": "lidarr_download_client", "name": "transmission", "instances": [{"attributes": {"id": 4, "name": "Transmission"}}]},
+		{"mode": "managed", "type": "lidarr_delay_profile", "name": "default", "instances": [{"attributes": {"id": 1}}]},
+		{"mode": "managed", "type": "null_resource", "name": "other", "instances": [{"attributes": {"id": "1"}}]}
+	]}`
+
+	err := run(context.Background(), []string{"-url", server.URL, "-api-key", "key", "-state", testStateFile(t, state)}, stdout, &bytes.Buffer{})
+	assert.ErrorIs(t, err, errUnchecked)
+	assert.Equal(t, `? lidarr_delay_profile.default (id 1) not checked, lidarr_delay_profile is not supported
+
+0 changed, 0 missing, 0 unmanaged, 1 unchecked.
+`, stdout.String())
+}
+
+func TestRunErrors(t *testing.T) {
+	t.Parallel()
+
+	server := testServer(t)
+	state := testStateFile(t, testState)
+
+	tests := map[string]struct {
+		args     []string
+		expected string
+	}{
+		"invalid format": {
+			args:     []string{"-url", server.URL, "-api-key", "key", "-state", state, "-format", "yaml"},
+			expected: `invalid format "yaml"`,
+		},
+		"missing key": {
+			args:     []string{"-url", server.URL, "-api-key", "", "-state", state},
+			expected: "API key cannot be an empty string",
+		},
+		"invalid state": {
+			args:     []string{"-url", server.URL, "-api-key", "key", "-state", testStateFile(t, "{")},
+			expected: "unable to parse state: unexpected EOF",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.EqualError(t, run(context.Background(), test.args, &bytes.Buffer{}, &bytes.Buffer{}), test.expected)
+		})
+	}
+}
+
+func TestRunComputedOnly(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/api/v1/artist" {
+			_, _ = w.Write([]byte(`[{"id":7,"artistName":"Queen","foreignArtistId":"0383dadf","monitored":true,"qualityProfileId":1,"metadataProfileId":1,
+				"path":"/music/Queen","status":"ended","overview":"Updated biography","genres":["Rock","Pop"]}]`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(server.Close)
+
+	stdout := &bytes.Buffer{}
+	state := `{"version": 4, "resources": [
+		{"mode": "managed", "type": "lidarr_artist", "name": "queen", "instances": [{"attributes": {
+			"id": 7, "artist_name": "Queen", "foreign_artist_id": "0383dadf", "monitored": true, "quality_profile_id": 1, "metadata_profile_id": 1,
+			"path": "/music/Queen", "status": "continuing", "overview": "Biography", "genres": ["Rock"]
+		}}]}
+	]}`
+
+	assert.NoError(t, run(context.Background(), []string{"-url", server.URL, "-api-key", "key", "-state", testStateFile(t, state)}, stdout, &bytes.Buffer{}))
+	assert.Equal(t, "No drift detected.\n", stdout.String())
+}
@@ -0,0 +1,134 @@
+// Command lidarr-export dumps the configuration of a live Lidarr instance
+// to Terraform resources and the related import blocks.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/provider"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	resourcesFile = "lidarr.tf"
+	importsFile   = "imports.tf"
+)
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stderr io.Writer) error {
+	flags := flag.NewFlagSet("lidarr-export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	apiURL := flags.String("url", os.Getenv("LIDARR_URL"), "Lidarr URL (e.g. http://localhost:8686), defaults to LIDARR_URL")
+	key := flags.String("api-key", os.Getenv("LIDARR_API_KEY"), "Lidarr API key, defaults to LIDARR_API_KEY")
+	out := flags.String("out", ".", "output directory")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	parsedURL, err := url.Parse(*apiURL)
+	if err != nil || parsedURL.Host == "" {
+		return fmt.Errorf("invalid URL %q", *apiURL)
+	}
+
+	if *key == "" {
+		return errors.New("API key cannot be an empty string")
+	}
+
+	config := lidarr.NewConfiguration()
+	config.HTTPClient = helpers.NewRetryableHTTPClient(&http.Client{Timeout: time.Minute}, 3, time.Second, 30*time.Second)
+
+	resources, diags := provider.Export(ctx, lidarr.NewAPIClient(config), helpers.NewAuthContext(ctx, parsedURL, *key))
+	for _, d := range diags {
+		fmt.Fprintf(stderr, "%s: %s\n", d.Summary(), d.Detail())
+	}
+
+	if diags.HasError() {
+		return errors.New("export failed")
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(*out, resourcesFile), resourcesHCL(resources), 0o600); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(*out, importsFile), importsHCL(resources), 0o600); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stderr, "exported %d resources to %s\n", len(resources), *out)
+
+	return nil
+}
+
+// resourcesHCL renders the resource blocks.
+func resourcesHCL(resources []provider.ExportedResource) []byte {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	for i, r := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		block := body.AppendNewBlock("resource", []string{r.Type, r.Name}).Body()
+
+		names := make([]string, 0, len(r.Attributes))
+		for name := range r.Attributes {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			block.SetAttributeValue(name, r.Attributes[name])
+		}
+	}
+
+	return hclwrite.Format(file.Bytes())
+}
+
+// importsHCL renders the import blocks.
+func importsHCL(resources []provider.ExportedResource) []byte {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	for i, r := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		block := body.AppendNewBlock("import", nil).Body()
+		block.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: r.Type},
+			hcl.TraverseAttr{Name: r.Name},
+		})
+		block.SetAttributeValue("id", cty.StringVal(r.ID))
+	}
+
+	return hclwrite.Format(file.Bytes())
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testResponses = map[string]string{
+	"/api/v1/tag":             `[{"id":1,"label":"flac"},{"id":2,"label":"FLAC"}]`,
+	"/api/v1/metadataprofile": `[{"id":1,"name":"None"},{"id":2,"name":"Standard","primaryAlbumTypes":[],"secondaryAlbumTypes":[],"releaseStatuses":[]}]`,
+	"/api/v1/notification": `[{"id":3,"name":"Discord","implementation":"Discord","configContract":"DiscordSettings","onGrab":true,"tags":[1],
+		"fields":[{"name":"webHookUrl","value":"https://discord.com/webhook"},{"name":"username","value":""},{"name":"grabFields","value":[0,1]}]}]`,
+	"/api/v1/downloadclient": `[{"id":4,"name":"Transmission","implementation":"Transmission","configContract":"TransmissionSettings","protocol":"torrent","enable":true,"priority":1,"tags":[],
+		"fields":[{"name":"host","value":"transmission"},{"name":"port","value":9091},{"name":"password","value":"********","privacy":"password"}]}]`,
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-key", r.Header.Get("X-Api-Key"))
+		w.Header().Set("Content-Type", "application/json")
+
+		if response, ok := testResponses[r.URL.Path]; ok {
+			_, _ = w.Write([]byte(response))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	out := t.TempDir()
+	stderr := &bytes.Buffer{}
+
+	assert.NoError(t, run(context.Background(), []string{"-url", server.URL, "-api-key", "test-key", "-out", out}, stderr))
+	assert.Contains(t, stderr.String(), "exported 5 resources")
+
+	resources, err := os.ReadFile(filepath.Join(out, resourcesFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(resources), `resource "lidarr_tag" "flac" {
+  label = "flac"
+}`)
+	assert.Contains(t, string(resources), `resource "lidarr_tag" "flac_2" {`)
+	assert.Contains(t, string(resources), `resource "lidarr_metadata_profile" "standard" {`)
+	assert.NotContains(t, string(resources), `"none"`)
+	assert.Contains(t, string(resources), `resource "lidarr_notification" "discord" {`)
+	assert.Regexp(t, `web_hook_url += "https://discord\.com/webhook"`, string(resources))
+	assert.Regexp(t, `grab_fields += \[0, 1\]`, string(resources))
+	assert.Regexp(t, `tags += \[1\]`, string(resources))
+	assert.NotContains(t, string(resources), `username`)
+	assert.Regexp(t, `host += "transmission"`, string(resources))
+	assert.NotContains(t, string(resources), `password`)
+	assert.NotContains(t, string(resources), "********")
+
+	imports, err := os.ReadFile(filepath.Join(out, importsFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(imports), `import {
+  to = lidarr_download_client.transmission
+  id = "4"
+}`)
+}
+
+func TestRunErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		args     []string
+		expected string
+	}{
+		"missing url": {
+			args:     []string{"-url", "", "-api-key", "key"},
+			expected: `invalid URL ""`,
+		},
+		"missing key": {
+			args:     []string{"-url", server.URL, "-api-key", ""},
+			expected: "API key cannot be an empty string",
+		},
+		"unauthorized": {
+			args:     []string{"-url", server.URL, "-api-key", "key", "-out", t.TempDir()},
+			expected: "export failed",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.EqualError(t, run(context.Background(), test.args, &bytes.Buffer{}), test.expected)
+		})
+	}
+}
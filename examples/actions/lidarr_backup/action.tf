@@ -0,0 +1,5 @@
+action "lidarr_backup" "example" {
+  config {
+    wait_for_completion = true
+  }
+}
@@ -0,0 +1,23 @@
+resource "lidarr_artist" "example" {
+  monitored           = true
+  artist_name         = "Queen"
+  root_folder_path    = "/music"
+  quality_profile_id  = 1
+  metadata_profile_id = 1
+  foreign_artist_id   = "0383dadf-2a4e-4d10-a46a-e9e041da8eb3"
+  monitor_new_items   = "all"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.lidarr_refresh_artist.example]
+    }
+  }
+}
+
+action "lidarr_refresh_artist" "example" {
+  config {
+    artist_ids          = [lidarr_artist.example.id]
+    wait_for_completion = true
+  }
+}
@@ -0,0 +1,21 @@
+resource "lidarr_root_folder" "example" {
+  name                    = "Example"
+  path                    = "/music"
+  quality_profile_id      = 1
+  metadata_profile_id     = 1
+  monitor_option          = "future"
+  new_item_monitor_option = "all"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.lidarr_rescan_root_folder.example]
+    }
+  }
+}
+
+action "lidarr_rescan_root_folder" "example" {
+  config {
+    folders = [lidarr_root_folder.example.path]
+  }
+}
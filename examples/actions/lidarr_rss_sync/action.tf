@@ -0,0 +1,6 @@
+action "lidarr_rss_sync" "example" {
+  config {
+    wait_for_completion = true
+    timeout             = 120
+  }
+}
@@ -0,0 +1 @@
+action "lidarr_search_missing" "example" {}
@@ -0,0 +1,3 @@
+data "lidarr_album" "example" {
+  foreign_album_id = "f5093c06-23e3-404f-aeaa-40f72885ee3a"
+}
@@ -0,0 +1,9 @@
+data "lidarr_album_lookup" "example" {
+  term = "Pink Floyd The Dark Side of the Moon"
+}
+
+resource "lidarr_album" "example" {
+  artist_id        = lidarr_artist.example.id
+  foreign_album_id = data.lidarr_album_lookup.example.albums[0].foreign_album_id
+  monitored        = true
+}
@@ -0,0 +1,4 @@
+data "lidarr_albums" "example" {
+  artist_id  = 1
+  album_type = "Album"
+}
@@ -0,0 +1,12 @@
+data "lidarr_artist_lookup" "example" {
+  term = "Radiohead"
+}
+
+resource "lidarr_artist" "example" {
+  artist_name         = data.lidarr_artist_lookup.example.artists[0].artist_name
+  foreign_artist_id   = data.lidarr_artist_lookup.example.artists[0].foreign_artist_id
+  root_folder_path    = "/music"
+  quality_profile_id  = 1
+  metadata_profile_id = 1
+  monitored           = true
+}
@@ -0,0 +1,2 @@
+data "lidarr_download_client_schema" "example" {
+}
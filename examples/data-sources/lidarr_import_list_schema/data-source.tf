@@ -0,0 +1,2 @@
+data "lidarr_import_list_schema" "example" {
+}
@@ -0,0 +1,6 @@
+data "lidarr_indexer_schema" "example" {
+}
+
+output "torznab_fields" {
+  value = [for f in data.lidarr_indexer_schema.example.implementations["Torznab"].fields : f.name]
+}
@@ -0,0 +1,2 @@
+data "lidarr_notification_schema" "example" {
+}
@@ -0,0 +1,6 @@
+resource "lidarr_album" "example" {
+  artist_id        = lidarr_artist.example.id
+  foreign_album_id = "f5093c06-23e3-404f-aeaa-40f72885ee3a"
+  monitored        = true
+  any_release_ok   = true
+}
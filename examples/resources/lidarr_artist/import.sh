@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import lidarr_artist.example 10
\ No newline at end of file
+terraform import lidarr_artist.example 10
+
+# import using the foreign artist ID
+terraform import lidarr_artist.example "name:83d91898-7763-47d7-b03b-b92132375c47"
\ No newline at end of file
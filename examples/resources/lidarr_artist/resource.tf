@@ -1,8 +1,14 @@
 resource "lidarr_artist" "example" {
   monitored           = true
   artist_name         = "Queen"
-  path                = "/music/Queen"
+  root_folder_path    = "/music"
   quality_profile_id  = 1
   metadata_profile_id = 1
   foreign_artist_id   = "0383dadf-2a4e-4d10-a46a-e9e041da8eb3"
+  monitor_new_items   = "all"
+
+  add_options = {
+    monitor                   = "future"
+    search_for_missing_albums = false
+  }
 }
@@ -0,0 +1,2 @@
+# import using the artist ID
+terraform import lidarr_artist_album_monitoring.example 10
\ No newline at end of file
@@ -0,0 +1,6 @@
+resource "lidarr_artist_album_monitoring" "example" {
+  artist_id                 = lidarr_artist.example.id
+  album_types               = ["Album", "EP"]
+  release_date_from         = "1970-01-01"
+  include_foreign_album_ids = ["f5093c06-23e3-404f-aeaa-40f72885ee3a"]
+}
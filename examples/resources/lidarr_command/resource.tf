@@ -0,0 +1,9 @@
+resource "lidarr_command" "example" {
+  name                = "RescanFolders"
+  wait_for_completion = true
+  timeout             = 600
+
+  triggers = {
+    root_folder = lidarr_root_folder.example.path
+  }
+}
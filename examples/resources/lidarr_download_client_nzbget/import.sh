@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import lidarr_download_client_nzbget.example 1
\ No newline at end of file
+terraform import lidarr_download_client_nzbget.example 1
+
+# import using the name
+terraform import lidarr_download_client_nzbget.example "name:Example"
\ No newline at end of file
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import lidarr_import_list_music_brainz.example 1
\ No newline at end of file
+terraform import lidarr_import_list_music_brainz.example 1
+
+# import using the name
+terraform import lidarr_import_list_music_brainz.example "name:Example"
\ No newline at end of file
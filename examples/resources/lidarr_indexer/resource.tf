@@ -8,4 +8,17 @@ resource "lidarr_indexer" "example" {
   api_path                = "/api"
   categories              = [8000, 5000]
   tags                    = [1, 2]
-}
\ No newline at end of file
+}
+# Fields without a dedicated attribute are set through the
+# free-form map, validated against the implementation schema.
+resource "lidarr_indexer" "torznab" {
+  name            = "Torznab"
+  implementation  = "Torznab"
+  protocol        = "torrent"
+  config_contract = "TorznabSettings"
+  base_url        = "https://feed.torznab.com"
+  api_path        = "/api"
+  fields = {
+    "rejectBlocklistedTorrentHashesWhileGrabbing" = "true"
+  }
+}
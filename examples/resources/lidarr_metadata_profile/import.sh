@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import lidarr_metadata_profile.example 10
\ No newline at end of file
+terraform import lidarr_metadata_profile.example 10
+
+# import using the name
+terraform import lidarr_metadata_profile.example "name:Example"
\ No newline at end of file
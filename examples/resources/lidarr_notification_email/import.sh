@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import lidarr_notification_email.example 1
\ No newline at end of file
+terraform import lidarr_notification_email.example 1
+
+# import using the name
+terraform import lidarr_notification_email.example "name:Example"
\ No newline at end of file
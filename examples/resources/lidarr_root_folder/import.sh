@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import lidarr_root_folder.example 10
\ No newline at end of file
+terraform import lidarr_root_folder.example 10
+
+# import using the path
+terraform import lidarr_root_folder.example "name:/music"
\ No newline at end of file
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import lidarr_tag.example 10
\ No newline at end of file
+terraform import lidarr_tag.example 10
+
+# import using the label
+terraform import lidarr_tag.example "name:hvec"
\ No newline at end of file
@@ -1,9 +1,14 @@
 package helpers
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 
-	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // define constant for error management.
@@ -13,22 +18,169 @@ const (
 	Update                            = "update"
 	Delete                            = "delete"
 	List                              = "list"
+	TestConnection                    = "test"
 	ClientError                       = "Client Error"
+	ClientWarning                     = "Client Warning"
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
+	FieldError                        = "Field Error"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
+	UnexpectedActionConfigureType     = "Unexpected Action Configure Type"
 )
 
 func ParseNotFoundError(kind, field, search string) string {
 	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s'", kind, kind, field, search)
 }
 
+func ParseRemoveFromStateWarning(kind string, id int64) string {
+	return fmt.Sprintf("Unable to find %s with ID %d, removing it from state so that it can be recreated", kind, id)
+}
+
+func ParseDeletedOutsideError(kind string, id int64) string {
+	return fmt.Sprintf("Unable to find %s with ID %d, it was deleted outside Terraform: refresh the state to recreate it", kind, id)
+}
+
+func ParseFieldError(name string, err error) string {
+	return fmt.Sprintf("Unable to map field %s, got error: %s", name, err)
+}
+
 func ParseClientError(action, name string, err error) string {
-	if e, ok := err.(*lidarr.GenericOpenAPIError); ok {
+	var e apiError
+	if errors.As(err, &e) {
 		return fmt.Sprintf("Unable to %s %s, got error: %s\nDetails:\n%s", action, name, err, string(e.Body()))
 	}
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// apiError is implemented by the errors of failed API calls, such as lidarr.GenericOpenAPIError.
+type apiError interface {
+	error
+	Body() []byte
+}
+
+// ResponseError describes a failed API call not made through the API client.
+type ResponseError struct {
+	status string
+	body   []byte
+}
+
+// NewResponseError returns the error of the given response, with its already read body.
+func NewResponseError(response *http.Response, body []byte) *ResponseError {
+	return &ResponseError{status: response.Status, body: body}
+}
+
+// Error returns the response status.
+func (e *ResponseError) Error() string {
+	return e.status
+}
+
+// Body returns the response body.
+func (e *ResponseError) Body() []byte {
+	return e.body
+}
+
+// ValidationFailure describes a validation failure returned by Lidarr.
+type ValidationFailure struct {
+	AttemptedValue interface{} `json:"attemptedValue"`
+	PropertyName   string      `json:"propertyName"`
+	ErrorMessage   string      `json:"errorMessage"`
+	Severity       string      `json:"severity"`
+	IsWarning      bool        `json:"isWarning"`
+}
+
+// Warning identifies failures not blocking the operation.
+func (f ValidationFailure) Warning() bool {
+	return f.IsWarning || strings.EqualFold(f.Severity, "warning") || strings.EqualFold(f.Severity, "info")
+}
+
+// ParseValidationFailures returns the validation failures of a failed API call, if any.
+func ParseValidationFailures(err error) []ValidationFailure {
+	var e apiError
+	if !errors.As(err, &e) {
+		return nil
+	}
+
+	var failures []ValidationFailure
+	if json.Unmarshal(e.Body(), &failures) != nil {
+		return nil
+	}
+
+	return failures
+}
+
+// AddValidationDiagnostics reports the validation failures of a failed API call, scoped to the attribute
+// returned by the given function when possible. Other errors are reported as client errors.
+// Attempted values are never reported, since they might be sensitive.
+func AddValidationDiagnostics(diags *diag.Diagnostics, action, name string, err error, attribute func(string) (path.Path, bool)) {
+	failures := ParseValidationFailures(err)
+	if len(failures) == 0 {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+
+		return
+	}
+
+	for _, f := range failures {
+		attributePath, found := attribute(f.PropertyName)
+
+		switch {
+		case f.Warning() && found:
+			diags.AddAttributeWarning(attributePath, ClientWarning, ParseValidationFailure(action, name, f))
+		case f.Warning():
+			diags.AddWarning(ClientWarning, ParseValidationFailure(action, name, f))
+		case found:
+			diags.AddAttributeError(attributePath, ClientError, ParseValidationFailure(action, name, f))
+		default:
+			diags.AddError(ClientError, ParseValidationFailure(action, name, f))
+		}
+	}
+}
+
+// AddClientError reports a failed API call as AddValidationDiagnostics does,
+// making sure it results in an error even if Lidarr only reported warnings.
+func AddClientError(diags *diag.Diagnostics, action, name string, err error, attribute func(string) (path.Path, bool)) {
+	errorsCount := diags.ErrorsCount()
+
+	AddValidationDiagnostics(diags, action, name, err, attribute)
+
+	if diags.ErrorsCount() == errorsCount {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+	}
+}
+
+// OnlyValidationWarnings identifies API calls failed because of validation warnings alone,
+// which Lidarr skips when saving with forceSave.
+func OnlyValidationWarnings(err error) bool {
+	failures := ParseValidationFailures(err)
+	for _, f := range failures {
+		if !f.Warning() {
+			return false
+		}
+	}
+
+	return len(failures) > 0
+}
+
+func ParseValidationFailure(action, name string, failure ValidationFailure) string {
+	if failure.PropertyName == "" {
+		return fmt.Sprintf("Unable to %s %s, got validation failure: %s", action, name, failure.ErrorMessage)
+	}
+
+	return fmt.Sprintf("Unable to %s %s, got validation failure on %s: %s", action, name, failure.PropertyName, failure.ErrorMessage)
+}
+
+// IsNotFound checks whether the API call failed because the requested object does not exist.
+func IsNotFound(response *http.Response, err error) bool {
+	if response != nil {
+		return response.StatusCode == http.StatusNotFound
+	}
+
+	var e apiError
+	if errors.As(err, &e) {
+		return strings.HasPrefix(e.Error(), fmt.Sprint(http.StatusNotFound))
+	}
+
+	return false
+}
@@ -1,10 +1,15 @@
 package helpers
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,6 +28,12 @@ func TestParseClientError(t *testing.T) {
 			err:      &lidarr.GenericOpenAPIError{},
 			expected: "Unable to create lidarr_tag, got error: \nDetails:\n",
 		},
+		"response": {
+			action:   "create",
+			name:     "lidarr_command",
+			err:      NewResponseError(&http.Response{Status: "400 Bad Request"}, []byte(`{"message":"boom"}`)),
+			expected: "Unable to create lidarr_command, got error: 400 Bad Request\nDetails:\n{\"message\":\"boom\"}",
+		},
 		"generic": {
 			action:   "create",
 			name:     "lidarr_tag",
@@ -65,3 +76,218 @@ func TestParseNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRemoveFromStateWarning(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		kind     string
+		id       int64
+		expected string
+	}{
+		"generic": {
+			kind:     "tag",
+			id:       1,
+			expected: "Unable to find tag with ID 1, removing it from state so that it can be recreated",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ParseRemoveFromStateWarning(test.kind, test.id))
+		})
+	}
+}
+
+func TestParseDeletedOutsideError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		kind     string
+		id       int64
+		expected string
+	}{
+		"generic": {
+			kind:     "album",
+			id:       1,
+			expected: "Unable to find album with ID 1, it was deleted outside Terraform: refresh the state to recreate it",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ParseDeletedOutsideError(test.kind, test.id))
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		response *http.Response
+		err      error
+		expected bool
+	}{
+		"not found": {
+			response: &http.Response{StatusCode: http.StatusNotFound},
+			err:      &lidarr.GenericOpenAPIError{},
+			expected: true,
+		},
+		"unauthorized": {
+			response: &http.Response{StatusCode: http.StatusUnauthorized},
+			err:      &lidarr.GenericOpenAPIError{},
+			expected: false,
+		},
+		"openapi without response": {
+			response: nil,
+			err:      &lidarr.GenericOpenAPIError{},
+			expected: false,
+		},
+		"response error": {
+			response: nil,
+			err:      NewResponseError(&http.Response{Status: "404 Not Found"}, nil),
+			expected: true,
+		},
+		"generic": {
+			response: nil,
+			err:      errors.New("connection refused"),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, IsNotFound(test.response, test.err))
+		})
+	}
+}
+
+// validationError returns the error of an API call answered with the given body.
+func validationError(t *testing.T, body string) error {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	config := lidarr.NewConfiguration()
+	config.Servers = lidarr.ServerConfigurations{{URL: server.URL}}
+
+	_, _, err := lidarr.NewAPIClient(config).TagAPI.ListTag(context.Background()).Execute()
+
+	return err
+}
+
+func TestAddValidationDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	attribute := func(property string) (path.Path, bool) {
+		if property == "BaseUrl" {
+			return path.Root("base_url"), true
+		}
+
+		return path.Empty(), false
+	}
+
+	tests := map[string]struct {
+		body     string
+		expected diag.Diagnostics
+	}{
+		"attribute error": {
+			body: `[{"propertyName":"BaseUrl","errorMessage":"Unable to connect","attemptedValue":"secret","severity":"error"}]`,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("base_url"), ClientError, "Unable to test indexer, got validation failure on BaseUrl: Unable to connect"),
+			},
+		},
+		"generic warning": {
+			body: `[{"propertyName":"","errorMessage":"No results","isWarning":true}]`,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(ClientWarning, "Unable to test indexer, got validation failure: No results"),
+			},
+		},
+		"mixed": {
+			body: `[{"propertyName":"ApiKey","errorMessage":"Invalid key","severity":"error"},{"propertyName":"BaseUrl","errorMessage":"Slow","severity":"warning"}]`,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(ClientError, "Unable to test indexer, got validation failure on ApiKey: Invalid key"),
+				diag.NewAttributeWarningDiagnostic(path.Root("base_url"), ClientWarning, "Unable to test indexer, got validation failure on BaseUrl: Slow"),
+			},
+		},
+		"not a validation failure": {
+			body: `{"message":"boom"}`,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(ClientError, "Unable to test indexer, got error: 400 Bad Request\nDetails:\n{\"message\":\"boom\"}"),
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			AddValidationDiagnostics(&diags, TestConnection, "indexer", validationError(t, test.body), attribute)
+			assert.Equal(t, test.expected, diags)
+		})
+	}
+}
+
+func TestAddClientError(t *testing.T) {
+	t.Parallel()
+
+	attribute := func(string) (path.Path, bool) { return path.Root("name"), true }
+
+	var diags diag.Diagnostics
+
+	err := validationError(t, `[{"propertyName":"Name","errorMessage":"Slow","isWarning":true}]`)
+	AddClientError(&diags, Create, "indexer", err, attribute)
+	assert.Equal(t, diag.Diagnostics{
+		diag.NewAttributeWarningDiagnostic(path.Root("name"), ClientWarning, "Unable to create indexer, got validation failure on Name: Slow"),
+		diag.NewErrorDiagnostic(ClientError, ParseClientError(Create, "indexer", err)),
+	}, diags)
+}
+
+func TestOnlyValidationWarnings(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"warnings": {
+			err:      validationError(t, `[{"propertyName":"Name","errorMessage":"Slow","isWarning":true},{"errorMessage":"Info","severity":"info"}]`),
+			expected: true,
+		},
+		"errors": {
+			err:      validationError(t, `[{"propertyName":"Name","errorMessage":"Slow","isWarning":true},{"errorMessage":"Empty","severity":"error"}]`),
+			expected: false,
+		},
+		"empty": {
+			err:      validationError(t, `[]`),
+			expected: false,
+		},
+		"other": {
+			err:      errors.New("other error"),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, OnlyValidationWarnings(test.err))
+		})
+	}
+}
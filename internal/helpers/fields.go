@@ -2,332 +2,421 @@ package helpers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"reflect"
-	"slices"
-	"strings"
+	"math"
+	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
-	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const SensitiveValue = "********"
 
-type fieldException struct {
-	apiName string
-	tfName  string
+// Field values are mapped to and from the resource attributes by the code generated
+// with tools/fieldgen. The helpers below convert a single value, so that the generated
+// code only has to pick the right one for each attribute type.
+
+// setField sets the lidarr field value.
+func setField(name string, value interface{}) lidarr.Field {
+	field := *lidarr.NewField()
+	field.SetName(name)
+	field.SetValue(value)
+
+	return field
 }
 
-// getFieldExceptions identifies the fields resources in which TF and API name differs.
-func getFieldExceptions() []fieldException {
-	return []fieldException{
-		{
-			apiName: "tags",
-			tfName:  "fieldTags",
-		},
-		{
-			apiName: "seedCriteria.seedTime",
-			tfName:  "seedTime",
-		},
-		{
-			apiName: "seedCriteria.seedRatio",
-			tfName:  "seedRatio",
-		},
-		{
-			apiName: "seedCriteria.discographySeedTime",
-			tfName:  "discographySeedTime",
-		},
-	}
+// unexpectedTypeError describes a field value not matching the expected type.
+func unexpectedTypeError(fieldOutput *lidarr.Field, expected string) error {
+	return fmt.Errorf("field '%s' has value %v of type %T, expected %s", fieldOutput.GetName(), fieldOutput.GetValue(), fieldOutput.GetValue(), expected)
 }
 
-// selectTFName identifies the TF name starting from API name.
-func selectTFName(name string) string {
-	for _, f := range getFieldExceptions() {
-		if f.apiName == name {
-			name = f.tfName
+// toInt64 converts a JSON number to int64, rejecting fractional values.
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, false
 		}
+
+		return int64(v), true
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case json.Number:
+		i, err := v.Int64()
+
+		return i, err == nil
+	default:
+		return 0, false
 	}
+}
+
+// toFloat64 converts a JSON number to float64.
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+
+		return f, err == nil
+	default:
+		i, ok := toInt64(value)
 
-	return name
+		return float64(i), ok
+	}
 }
 
-// selectAPIName identifies the API name starting from TF name.
-func selectAPIName(name string) string {
-	for _, f := range getFieldExceptions() {
-		if f.tfName == name {
-			name = f.apiName
+// StringFieldValue converts a lidarr field value into a string attribute.
+// A masked value keeps the current one, if known.
+func StringFieldValue(field *lidarr.Field, current types.String) (types.String, error) {
+	switch v := field.GetValue().(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		if v == SensitiveValue {
+			return knownString(current), nil
 		}
-	}
 
-	return name
+		return types.StringValue(v), nil
+	case bool, float64, int, int32, int64, json.Number:
+		return types.StringValue(fmt.Sprint(v)), nil
+	default:
+		return types.StringNull(), unexpectedTypeError(field, "a string")
+	}
 }
 
-// selectWriteField identifies which struct field should be written.
-func selectWriteField(fieldOutput *lidarr.Field, fieldCase interface{}) reflect.Value {
-	fieldName := selectTFName(fieldOutput.GetName())
-	value := reflect.ValueOf(fieldCase).Elem()
+// SensitiveFieldValue converts a lidarr sensitive field value into a string attribute.
+// Since the API never returns secrets, a masked or empty value keeps the current one, if known.
+func SensitiveFieldValue(field *lidarr.Field, current types.String) (types.String, error) {
+	if field.GetValue() == "" {
+		return knownString(current), nil
+	}
 
-	return value.FieldByNameFunc(func(n string) bool { return strings.EqualFold(n, fieldName) })
+	return StringFieldValue(field, current)
 }
 
-// selectReadField identifies which struct field should be read.
-func selectReadField(name string, fieldCase interface{}) reflect.Value {
-	value := reflect.ValueOf(fieldCase)
-	value = value.Elem()
+// knownString returns the value if known, null otherwise.
+func knownString(value types.String) types.String {
+	if value.IsUnknown() {
+		return types.StringNull()
+	}
 
-	return value.FieldByNameFunc(func(n string) bool { return strings.EqualFold(n, name) })
+	return value
 }
 
-// setField sets the lidarr field value.
-func setField(name string, value interface{}) lidarr.Field {
-	field := *lidarr.NewField()
-	field.SetName(name)
-	field.SetValue(value)
+// BoolFieldValue converts a lidarr field value into a bool attribute.
+func BoolFieldValue(field *lidarr.Field) (types.Bool, error) {
+	if isEmptyField(field) {
+		return types.BoolNull(), nil
+	}
 
-	return field
+	value, ok := field.GetValue().(bool)
+	if !ok {
+		return types.BoolNull(), unexpectedTypeError(field, "a boolean")
+	}
+
+	return types.BoolValue(value), nil
 }
 
-// writeStringField writes a lidarr string field into struct field.
-func writeStringField(fieldOutput *lidarr.Field, fieldCase interface{}) {
-	stringValue := fmt.Sprint(fieldOutput.GetValue())
+// Int64FieldValue converts a lidarr field value into an int attribute.
+func Int64FieldValue(field *lidarr.Field) (types.Int64, error) {
+	if isEmptyField(field) {
+		return types.Int64Null(), nil
+	}
 
-	v := reflect.ValueOf(types.StringValue(stringValue))
-	if fieldOutput.GetValue() == nil {
-		v = reflect.ValueOf(types.StringNull())
+	value, ok := toInt64(field.GetValue())
+	if !ok {
+		return types.Int64Null(), unexpectedTypeError(field, "an integer")
 	}
 
-	selectWriteField(fieldOutput, fieldCase).Set(v)
+	return types.Int64Value(value), nil
 }
 
-// writeBoolField writes a lidarr bool field into struct field.
-func writeBoolField(fieldOutput *lidarr.Field, fieldCase interface{}) {
-	boolValue, _ := fieldOutput.GetValue().(bool)
+// Float64FieldValue converts a lidarr field value into a float attribute.
+func Float64FieldValue(field *lidarr.Field) (types.Float64, error) {
+	if isEmptyField(field) {
+		return types.Float64Null(), nil
+	}
 
-	v := reflect.ValueOf(types.BoolValue(boolValue))
-	if fieldOutput.GetValue() == nil {
-		v = reflect.ValueOf(types.BoolNull())
+	value, ok := toFloat64(field.GetValue())
+	if !ok {
+		return types.Float64Null(), unexpectedTypeError(field, "a number")
 	}
 
-	selectWriteField(fieldOutput, fieldCase).Set(v)
+	return types.Float64Value(value), nil
 }
 
-// writeIntField writes a lidarr int field into struct field.
-func writeIntField(fieldOutput *lidarr.Field, fieldCase interface{}) {
-	intValue, _ := fieldOutput.GetValue().(float64)
+// StringSetFieldValue converts a lidarr field value into a string set attribute.
+func StringSetFieldValue(field *lidarr.Field) (types.Set, error) {
+	if isEmptyField(field) {
+		return types.SetNull(types.StringType), nil
+	}
 
-	v := reflect.ValueOf(types.Int64Value(int64(intValue)))
-	if fieldOutput.GetValue() == nil {
-		v = reflect.ValueOf(types.Int64Null())
+	slice, ok := field.GetValue().([]interface{})
+	if !ok {
+		return types.SetNull(types.StringType), unexpectedTypeError(field, "a list of strings")
 	}
 
-	selectWriteField(fieldOutput, fieldCase).Set(v)
-}
+	elements := make([]attr.Value, len(slice))
 
-// writeFloatField writes a lidarr float field into struct field.
-func writeFloatField(fieldOutput *lidarr.Field, fieldCase interface{}) {
-	floatValue, _ := fieldOutput.GetValue().(float64)
+	for i, e := range slice {
+		s, ok := e.(string)
+		if !ok {
+			return types.SetNull(types.StringType), unexpectedTypeError(field, "a list of strings")
+		}
 
-	v := reflect.ValueOf(types.Float64Value(floatValue))
-	if fieldOutput.GetValue() == nil {
-		v = reflect.ValueOf(types.Float64Null())
+		elements[i] = types.StringValue(s)
 	}
 
-	selectWriteField(fieldOutput, fieldCase).Set(v)
+	return types.SetValueMust(types.StringType, elements), nil
 }
 
-// writeStringSliceField writes a lidarr string slice field into struct field.
-func writeStringSliceField(ctx context.Context, fieldOutput *lidarr.Field, fieldCase interface{}) {
-	sliceValue, _ := fieldOutput.GetValue().([]interface{})
-	setValue := types.SetValueMust(types.StringType, nil)
-	tfsdk.ValueFrom(ctx, sliceValue, setValue.Type(ctx), &setValue)
-	v := reflect.ValueOf(setValue)
-	selectWriteField(fieldOutput, fieldCase).Set(v)
-}
+// Int64SetFieldValue converts a lidarr field value into an int set attribute.
+func Int64SetFieldValue(field *lidarr.Field) (types.Set, error) {
+	if isEmptyField(field) {
+		return types.SetNull(types.Int64Type), nil
+	}
 
-// writeIntSliceField writes a lidarr int slice field into struct field.
-func writeIntSliceField(ctx context.Context, fieldOutput *lidarr.Field, fieldCase interface{}) {
-	sliceValue, _ := fieldOutput.GetValue().([]interface{})
-	setValue := types.SetValueMust(types.Int64Type, nil)
-	tfsdk.ValueFrom(ctx, sliceValue, setValue.Type(ctx), &setValue)
-	v := reflect.ValueOf(setValue)
-	selectWriteField(fieldOutput, fieldCase).Set(v)
-}
+	slice, ok := field.GetValue().([]interface{})
+	if !ok {
+		return types.SetNull(types.Int64Type), unexpectedTypeError(field, "a list of integers")
+	}
+
+	elements := make([]attr.Value, len(slice))
 
-// readStringField reads from a string struct field and return a lidarr field.
-func readStringField(name string, fieldCase interface{}) lidarr.Field {
-	fieldName := selectAPIName(name)
-	stringField := (*types.String)(selectReadField(name, fieldCase).Addr().UnsafePointer())
+	for i, e := range slice {
+		n, ok := toInt64(e)
+		if !ok {
+			return types.SetNull(types.Int64Type), unexpectedTypeError(field, "a list of integers")
+		}
 
-	if !stringField.IsNull() && !stringField.IsUnknown() {
-		return setField(fieldName, stringField.ValueString())
+		elements[i] = types.Int64Value(n)
 	}
 
-	return *lidarr.NewField()
+	return types.SetValueMust(types.Int64Type, elements), nil
+}
+
+// isEmptyField identifies fields without a value, including masked ones.
+func isEmptyField(field *lidarr.Field) bool {
+	return field.GetValue() == nil || field.GetValue() == SensitiveValue
 }
 
-// readBoolField reads from a bool struct field and return a lidarr field.
-func readBoolField(name string, fieldCase interface{}) lidarr.Field {
-	fieldName := selectAPIName(name)
-	boolField := (*types.Bool)(selectReadField(name, fieldCase).Addr().UnsafePointer())
+// AppendStringField appends the string attribute, if set, as a lidarr field.
+func AppendStringField(fields []lidarr.Field, name string, value types.String) []lidarr.Field {
+	if value.IsNull() || value.IsUnknown() {
+		return fields
+	}
 
-	if !boolField.IsNull() && !boolField.IsUnknown() {
-		return setField(fieldName, boolField.ValueBool())
+	return append(fields, setField(name, value.ValueString()))
+}
+
+// AppendSensitiveField appends the sensitive string attribute, if set, as a lidarr field.
+func AppendSensitiveField(fields []lidarr.Field, name string, value types.String) []lidarr.Field {
+	if value.IsNull() || value.IsUnknown() {
+		return fields
 	}
 
-	return *lidarr.NewField()
+	return append(fields, setField(name, value.ValueString()))
 }
 
-// readIntField reads from a int struct field and return a lidarr field.
-func readIntField(name string, fieldCase interface{}) lidarr.Field {
-	fieldName := selectAPIName(name)
-	intField := (*types.Int64)(selectReadField(name, fieldCase).Addr().UnsafePointer())
+// AppendMaskedField appends the masked value of a secret not set anymore, but known in the prior state,
+// so that the API keeps the stored one. The API only replaces the masked value when updating an existing
+// definition, hence it must never be sent on creation.
+func AppendMaskedField(fields []lidarr.Field, name string, value, prior types.String) []lidarr.Field {
+	if (!value.IsNull() && !value.IsUnknown()) || prior.IsNull() || prior.IsUnknown() || prior.ValueString() == "" {
+		return fields
+	}
+
+	return append(fields, setField(name, SensitiveValue))
+}
 
-	if !intField.IsNull() && !intField.IsUnknown() {
-		return setField(fieldName, intField.ValueInt64())
+// AppendBoolField appends the bool attribute, if set, as a lidarr field.
+func AppendBoolField(fields []lidarr.Field, name string, value types.Bool) []lidarr.Field {
+	if value.IsNull() || value.IsUnknown() {
+		return fields
 	}
 
-	return *lidarr.NewField()
+	return append(fields, setField(name, value.ValueBool()))
 }
 
-// readFloatField reads from a float struct field and return a lidarr field.
-func readFloatField(name string, fieldCase interface{}) lidarr.Field {
-	fieldName := selectAPIName(name)
-	floatField := (*types.Float64)(selectReadField(name, fieldCase).Addr().UnsafePointer())
+// AppendInt64Field appends the int attribute, if set, as a lidarr field.
+func AppendInt64Field(fields []lidarr.Field, name string, value types.Int64) []lidarr.Field {
+	if value.IsNull() || value.IsUnknown() {
+		return fields
+	}
+
+	return append(fields, setField(name, value.ValueInt64()))
+}
 
-	if !floatField.IsNull() && !floatField.IsUnknown() {
-		return setField(fieldName, floatField.ValueFloat64())
+// AppendFloat64Field appends the float attribute, if set, as a lidarr field.
+func AppendFloat64Field(fields []lidarr.Field, name string, value types.Float64) []lidarr.Field {
+	if value.IsNull() || value.IsUnknown() {
+		return fields
 	}
 
-	return *lidarr.NewField()
+	return append(fields, setField(name, value.ValueFloat64()))
 }
 
-// readStringSliceField reads from a string slice struct field and return a lidarr field.
-func readStringSliceField(ctx context.Context, name string, fieldCase interface{}) lidarr.Field {
-	fieldName := selectAPIName(name)
-	sliceField := (*types.Set)(selectReadField(name, fieldCase).Addr().UnsafePointer())
+// AppendStringSetField appends the string set attribute, if not empty, as a lidarr field.
+func AppendStringSetField(ctx context.Context, fields []lidarr.Field, name string, value types.Set, diags *diag.Diagnostics) []lidarr.Field {
+	if len(value.Elements()) == 0 {
+		return fields
+	}
 
-	if len(sliceField.Elements()) != 0 {
-		slice := make([]string, len(sliceField.Elements()))
-		tfsdk.ValueAs(ctx, sliceField, &slice)
+	slice := make([]string, len(value.Elements()))
+	if value.ElementsAs(ctx, &slice, false).HasError() {
+		diags.AddError(FieldError, ParseFieldError(name, fmt.Errorf("attribute for field '%s' is not a set of strings", name)))
 
-		return setField(fieldName, slice)
+		return fields
 	}
 
-	return *lidarr.NewField()
+	return append(fields, setField(name, slice))
 }
 
-// readIntSliceField reads from a int slice struct field and return a lidarr field.
-func readIntSliceField(ctx context.Context, name string, fieldCase interface{}) lidarr.Field {
-	fieldName := selectAPIName(name)
-	sliceField := (*types.Set)(selectReadField(name, fieldCase).Addr().UnsafePointer())
+// AppendInt64SetField appends the int set attribute, if not empty, as a lidarr field.
+func AppendInt64SetField(ctx context.Context, fields []lidarr.Field, name string, value types.Set, diags *diag.Diagnostics) []lidarr.Field {
+	if len(value.Elements()) == 0 {
+		return fields
+	}
 
-	if len(sliceField.Elements()) != 0 {
-		slice := make([]int64, len(sliceField.Elements()))
-		tfsdk.ValueAs(ctx, sliceField, &slice)
+	slice := make([]int64, len(value.Elements()))
+	if value.ElementsAs(ctx, &slice, false).HasError() {
+		diags.AddError(FieldError, ParseFieldError(name, fmt.Errorf("attribute for field '%s' is not a set of integers", name)))
 
-		return setField(fieldName, slice)
+		return fields
 	}
 
-	return *lidarr.NewField()
+	return append(fields, setField(name, slice))
 }
 
-// Fields contains all the field lists of a specific resource per type.
-type Fields struct {
-	Bools                  []string
-	BoolsExceptions        []string
-	Ints                   []string
-	IntsExceptions         []string
-	Strings                []string
-	StringsExceptions      []string
-	Floats                 []string
-	FloatsExceptions       []string
-	IntSlices              []string
-	IntSlicesExceptions    []string
-	StringSlices           []string
-	StringSlicesExceptions []string
+// schemaFieldKind returns the type of a schema field.
+// When the type is not provided, it is inferred from the default value.
+func schemaFieldKind(field *lidarr.Field) string {
+	if field.GetType() != "" {
+		return field.GetType()
+	}
+
+	switch field.GetValue().(type) {
+	case bool:
+		return "checkbox"
+	case float64, json.Number:
+		return "number"
+	case []interface{}:
+		return "tag"
+	default:
+		return "textbox"
+	}
 }
 
-// getList return a specific list of fields.
-func (f Fields) getList(list string) []string {
-	r := reflect.ValueOf(f)
-	output, _ := reflect.Indirect(r).FieldByName(list).Interface().([]string)
+// SchemaFieldValue converts a free-form string value into the API value expected by the schema field.
+func SchemaFieldValue(field *lidarr.Field, value string) (interface{}, error) {
+	switch schemaFieldKind(field) {
+	case "checkbox":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' is not a boolean", value)
+		}
+
+		return b, nil
+	case "number":
+		return schemaNumberValue(field, value)
+	case "select":
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n, nil
+		}
+
+		if list, err := schemaListValue(value); err == nil {
+			return list, nil
+		}
 
-	return output
+		return value, nil
+	case "tag", "tagSelect", "keyValueList":
+		return schemaListValue(value)
+	default:
+		return value, nil
+	}
 }
 
-// ReadFields takes in input a field container and populates a lidarr.Field slice.
-func ReadFields(ctx context.Context, fieldContainer interface{}, fieldLists Fields) []lidarr.Field {
-	var output []lidarr.Field
-
-	// Map each list to its read function.
-	readFuncs := map[string]func(string, interface{}) lidarr.Field{
-		"Bools":   readBoolField,
-		"Ints":    readIntField,
-		"Floats":  readFloatField,
-		"Strings": readStringField,
-		"StringSlices": func(name string, fieldContainer interface{}) lidarr.Field {
-			return readStringSliceField(ctx, name, fieldContainer)
-		},
-		"IntSlices": func(name string, fieldContainer interface{}) lidarr.Field {
-			return readIntSliceField(ctx, name, fieldContainer)
-		},
-	}
-
-	// Loop over the map to populate the lidarr.Field slice.
-	for fieldType, readFunc := range readFuncs {
-		for _, f := range fieldLists.getList(fieldType) {
-			if field := readFunc(f, fieldContainer); field.HasName() {
-				output = append(output, field)
-			}
+// schemaNumberValue converts a free-form value into a number.
+func schemaNumberValue(field *lidarr.Field, value string) (interface{}, error) {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, fmt.Errorf("value '%s' is not a number", value)
+	}
+
+	if field.GetIsFloat() {
+		return f, nil
+	}
+
+	n, ok := toInt64(f)
+	if !ok {
+		// Fractions are only allowed when the schema does not define the type.
+		if field.GetType() == "" {
+			return f, nil
 		}
+
+		return nil, fmt.Errorf("value '%s' is not an integer", value)
 	}
 
-	return output
+	return n, nil
 }
 
-// WriteFields takes in input a lidarr.Field slice and populate the relevant container fields.
-func WriteFields(ctx context.Context, fieldContainer interface{}, fields []lidarr.Field, fieldLists Fields) {
-	// Map each list to its write function.
-	writeFuncs := map[string]func(*lidarr.Field, interface{}){
-		"Bools":             writeBoolField,
-		"BoolsExceptions":   writeBoolField,
-		"Ints":              writeIntField,
-		"IntsExceptions":    writeIntField,
-		"Strings":           writeStringField,
-		"StringsExceptions": writeStringField,
-		"Floats":            writeFloatField,
-		"FloatsExceptions":  writeFloatField,
-		"IntSlices": func(fieldOutput *lidarr.Field, fieldContainer interface{}) {
-			writeIntSliceField(ctx, fieldOutput, fieldContainer)
-		},
-		"IntSlicesExceptions": func(fieldOutput *lidarr.Field, fieldContainer interface{}) {
-			writeIntSliceField(ctx, fieldOutput, fieldContainer)
-		},
-		"StringSlices": func(fieldOutput *lidarr.Field, fieldContainer interface{}) {
-			writeStringSliceField(ctx, fieldOutput, fieldContainer)
-		},
-		"StringSlicesExceptions": func(fieldOutput *lidarr.Field, fieldContainer interface{}) {
-			writeStringSliceField(ctx, fieldOutput, fieldContainer)
-		},
-	}
-
-	// Loop over each field and populate the related container field with the corresponding write function.
-	for _, f := range fields {
-		fieldName := f.GetName()
-		// Manage sensitive data.
-		if f.GetValue() == SensitiveValue {
-			if tempField := readStringField(fieldName, fieldContainer); tempField.GetValue() != nil {
-				f = tempField
-			}
-		}
+// schemaListValue converts a free-form value in JSON format into a list.
+func schemaListValue(value string) ([]interface{}, error) {
+	var list []interface{}
+	if err := json.Unmarshal([]byte(value), &list); err != nil || list == nil {
+		return nil, fmt.Errorf("value '%s' is not a JSON list", value)
+	}
 
-		for listName, writeFunc := range writeFuncs {
-			if slices.Contains(fieldLists.getList(listName), fieldName) {
-				writeFunc(&f, fieldContainer)
+	return list, nil
+}
 
-				break
-			}
+// FieldValueString converts an API field value into its free-form string representation.
+func FieldValueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		output, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
 		}
+
+		return string(output)
 	}
 }
+
+// SameFieldValue identifies a free-form string value matching the API value,
+// so that equivalent representations such as "1.50" and 1.5 do not cause a diff.
+func SameFieldValue(value string, apiValue interface{}) bool {
+	if value == FieldValueString(apiValue) {
+		return true
+	}
+
+	return canonicalJSON(value) != "" && canonicalJSON(value) == canonicalJSON(FieldValueString(apiValue))
+}
+
+// canonicalJSON returns the JSON value re-encoded, with sorted keys and no spacing, or empty if not valid.
+func canonicalJSON(value string) string {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return ""
+	}
+
+	output, _ := json.Marshal(decoded)
+
+	return string(output)
+}
@@ -2,82 +2,179 @@ package helpers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"math"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
-	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 )
 
+// Test mimics the field mapping generated by tools/fieldgen.
 type Test struct {
 	Fl       types.Float64
 	Set      types.Set
+	IntSet   types.Set
 	Str      types.String
+	Secret   types.String
 	In       types.Int64
 	SeedTime types.Int64
 	Boo      types.Bool
 }
 
-func TestWriteStringField(t *testing.T) {
-	t.Parallel()
-
-	value := "string"
+func (t *Test) writeFields(fields []lidarr.Field, diags *diag.Diagnostics) {
+	for _, field := range fields {
+		var err error
+
+		switch field.GetName() {
+		case "fl":
+			t.Fl, err = Float64FieldValue(&field)
+		case "set":
+			t.Set, err = StringSetFieldValue(&field)
+		case "intSet":
+			t.IntSet, err = Int64SetFieldValue(&field)
+		case "str":
+			t.Str, err = StringFieldValue(&field, t.Str)
+		case "secret":
+			t.Secret, err = SensitiveFieldValue(&field, t.Secret)
+		case "in":
+			t.In, err = Int64FieldValue(&field)
+		case "seedCriteria.seedTime":
+			t.SeedTime, err = Int64FieldValue(&field)
+		case "boo":
+			t.Boo, err = BoolFieldValue(&field)
+		}
 
-	tests := map[string]struct {
-		fieldOutput lidarr.Field
-		value       *string
-		written     Test
-		expected    Test
-	}{
-		"working": {
-			value:    &value,
-			written:  Test{},
-			expected: Test{Str: types.StringValue(value)},
-		},
-		"nil": {
-			value:    nil,
-			written:  Test{},
-			expected: Test{Str: types.StringNull()},
-		},
+		if err != nil {
+			diags.AddError(FieldError, ParseFieldError(field.GetName(), err))
+		}
 	}
-	for name, test := range tests {
-		test := test
+}
 
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
+func (t *Test) readFields(ctx context.Context, diags *diag.Diagnostics) []lidarr.Field {
+	var fields []lidarr.Field
 
-			field := lidarr.NewField()
-			if test.value != nil {
-				field.SetValue(*test.value)
-			}
+	fields = AppendFloat64Field(fields, "fl", t.Fl)
+	fields = AppendStringSetField(ctx, fields, "set", t.Set, diags)
+	fields = AppendInt64SetField(ctx, fields, "intSet", t.IntSet, diags)
+	fields = AppendStringField(fields, "str", t.Str)
+	fields = AppendSensitiveField(fields, "secret", t.Secret)
+	fields = AppendInt64Field(fields, "in", t.In)
+	fields = AppendInt64Field(fields, "seedCriteria.seedTime", t.SeedTime)
+	fields = AppendBoolField(fields, "boo", t.Boo)
 
-			field.SetName("str")
-			writeStringField(field, &test.written)
-			assert.Equal(t, test.expected, test.written)
-		})
+	return fields
+}
+
+// emptyTest returns a container with no field set.
+func emptyTest() Test {
+	return Test{
+		Fl:       types.Float64Null(),
+		Set:      types.SetNull(types.StringType),
+		IntSet:   types.SetNull(types.Int64Type),
+		Str:      types.StringNull(),
+		Secret:   types.StringNull(),
+		In:       types.Int64Null(),
+		SeedTime: types.Int64Null(),
+		Boo:      types.BoolNull(),
 	}
 }
 
-func TestWriteBoolField(t *testing.T) {
+func TestFieldValues(t *testing.T) {
 	t.Parallel()
 
-	value := true
-
 	tests := map[string]struct {
-		value    *bool
-		written  Test
-		expected Test
+		field    lidarr.Field
+		expected attr.Value
+		convert  func(*lidarr.Field) (attr.Value, error)
 	}{
-		"working": {
-			value:    &value,
-			written:  Test{},
-			expected: Test{Boo: types.BoolValue(value)},
+		"string": {
+			field:    setField("str", "string"),
+			expected: types.StringValue("string"),
+			convert: func(f *lidarr.Field) (attr.Value, error) {
+				return StringFieldValue(f, types.StringNull())
+			},
+		},
+		"string from number": {
+			field:    setField("str", float64(5)),
+			expected: types.StringValue("5"),
+			convert: func(f *lidarr.Field) (attr.Value, error) {
+				return StringFieldValue(f, types.StringNull())
+			},
+		},
+		"string nil": {
+			field:    setField("str", nil),
+			expected: types.StringNull(),
+			convert: func(f *lidarr.Field) (attr.Value, error) {
+				return StringFieldValue(f, types.StringValue("old"))
+			},
 		},
-		"nil": {
-			value:    nil,
-			written:  Test{},
-			expected: Test{Boo: types.BoolNull()},
+		"bool": {
+			field:    setField("boo", true),
+			expected: types.BoolValue(true),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return BoolFieldValue(f) },
+		},
+		"bool nil": {
+			field:    setField("boo", nil),
+			expected: types.BoolNull(),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return BoolFieldValue(f) },
+		},
+		"int": {
+			// use float to simulate unmarshal response
+			field:    setField("in", float64(50)),
+			expected: types.Int64Value(50),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return Int64FieldValue(f) },
+		},
+		"int nil": {
+			field:    setField("in", nil),
+			expected: types.Int64Null(),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return Int64FieldValue(f) },
+		},
+		"float": {
+			field:    setField("fl", 3.5),
+			expected: types.Float64Value(3.5),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return Float64FieldValue(f) },
+		},
+		"float from int": {
+			field:    setField("fl", int64(3)),
+			expected: types.Float64Value(3),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return Float64FieldValue(f) },
+		},
+		"float nil": {
+			field:    setField("fl", nil),
+			expected: types.Float64Null(),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return Float64FieldValue(f) },
+		},
+		"string set": {
+			// use interface to simulate unmarshal response
+			field:    setField("set", []interface{}{"test1", "test2"}),
+			expected: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test1"), types.StringValue("test2")}),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return StringSetFieldValue(f) },
+		},
+		"string set nil": {
+			field:    setField("set", nil),
+			expected: types.SetNull(types.StringType),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return StringSetFieldValue(f) },
+		},
+		"int set": {
+			field:    setField("set", []interface{}{float64(1), float64(2)}),
+			expected: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2)}),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return Int64SetFieldValue(f) },
+		},
+		"int set nil": {
+			field:    setField("set", nil),
+			expected: types.SetNull(types.Int64Type),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return Int64SetFieldValue(f) },
+		},
+		"masked int": {
+			field:    setField("in", SensitiveValue),
+			expected: types.Int64Null(),
+			convert:  func(f *lidarr.Field) (attr.Value, error) { return Int64FieldValue(f) },
 		},
 	}
 	for name, test := range tests {
@@ -86,47 +183,50 @@ func TestWriteBoolField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			field := lidarr.NewField()
-			if test.value != nil {
-				field.SetValue(*test.value)
-			}
-
-			field.SetName("boo")
-			writeBoolField(field, &test.written)
-			assert.Equal(t, test.expected, test.written)
+			value, err := test.convert(&test.field)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
 		})
 	}
 }
 
-func TestWriteIntField(t *testing.T) {
+func TestSensitiveFieldValue(t *testing.T) {
 	t.Parallel()
 
-	value := float64(50)
-
 	tests := map[string]struct {
-		// use float to simulate unmarshal response
-		value    *float64
-		name     string
-		written  Test
-		expected Test
+		value    interface{}
+		current  types.String
+		expected types.String
 	}{
-		"working": {
-			name:     "in",
-			value:    &value,
-			written:  Test{},
-			expected: Test{In: types.Int64Value(50)},
-		},
-		"seedtime": {
-			name:     "seedCriteria.seedTime",
-			value:    &value,
-			written:  Test{},
-			expected: Test{SeedTime: types.Int64Value(50)},
-		},
-		"nil": {
-			name:     "in",
-			value:    nil,
-			written:  Test{},
-			expected: Test{In: types.Int64Null()},
+		"masked known": {
+			value:    SensitiveValue,
+			current:  types.StringValue("secret"),
+			expected: types.StringValue("secret"),
+		},
+		"masked unknown": {
+			value:    SensitiveValue,
+			current:  types.StringUnknown(),
+			expected: types.StringNull(),
+		},
+		"masked imported": {
+			value:    SensitiveValue,
+			current:  types.StringNull(),
+			expected: types.StringNull(),
+		},
+		"empty imported": {
+			value:    "",
+			current:  types.StringNull(),
+			expected: types.StringNull(),
+		},
+		"empty configured": {
+			value:    "",
+			current:  types.StringValue(""),
+			expected: types.StringValue(""),
+		},
+		"changed": {
+			value:    "new",
+			current:  types.StringValue("secret"),
+			expected: types.StringValue("new"),
 		},
 	}
 	for name, test := range tests {
@@ -135,34 +235,68 @@ func TestWriteIntField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			field := lidarr.NewField()
-			if test.value != nil {
-				field.SetValue(*test.value)
-			}
-
-			field.SetName(test.name)
-			writeIntField(field, &test.written)
-			assert.Equal(t, test.expected, test.written)
+			field := setField("secret", test.value)
+			value, err := SensitiveFieldValue(&field, test.current)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
 		})
 	}
 }
 
-func TestWriteFloatField(t *testing.T) {
+func TestWriteFields(t *testing.T) {
 	t.Parallel()
 
-	value := float64(3.5)
-
 	tests := map[string]struct {
-		value    *float64
-		written  Test
-		expected Test
+		name      string
+		value     interface{}
+		container Test
+		expected  Test
 	}{
-		"working": {
-			value:    &value,
-			written:  Test{},
-			expected: Test{Fl: types.Float64Value(value)},
+		"string": {
+			name:     "str",
+			value:    "String",
+			expected: Test{Str: types.StringValue("String")},
+		},
+		"int": {
+			name:     "in",
+			value:    float64(55),
+			expected: Test{In: types.Int64Value(55)},
+		},
+		"seed time": {
+			name:     "seedCriteria.seedTime",
+			value:    float64(55),
+			expected: Test{SeedTime: types.Int64Value(55)},
+		},
+		"bool": {
+			name:     "boo",
+			value:    true,
+			expected: Test{Boo: types.BoolValue(true)},
+		},
+		"float": {
+			name:     "fl",
+			value:    5.5,
+			expected: Test{Fl: types.Float64Value(5.5)},
+		},
+		"string set": {
+			name:     "set",
+			value:    []interface{}{"test1", "test2"},
+			expected: Test{Set: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test1"), types.StringValue("test2")})},
+		},
+		"int set": {
+			name:     "intSet",
+			value:    []interface{}{float64(1), float64(9)},
+			expected: Test{IntSet: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(9)})},
+		},
+		"masked string": {
+			name:      "str",
+			value:     SensitiveValue,
+			container: Test{Str: types.StringValue("String")},
+			expected:  Test{Str: types.StringValue("String")},
+		},
+		"unknown field": {
+			name:  "unknown",
+			value: "String",
 		},
-		"nil": {},
 	}
 	for name, test := range tests {
 		test := test
@@ -170,37 +304,57 @@ func TestWriteFloatField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			field := lidarr.NewField()
-			if test.value != nil {
-				field.SetValue(*test.value)
-			}
+			var diags diag.Diagnostics
 
-			field.SetName("fl")
-			writeFloatField(field, &test.written)
-			assert.Equal(t, test.expected, test.written)
+			test.container.writeFields([]lidarr.Field{setField(test.name, test.value)}, &diags)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.expected, test.container)
 		})
 	}
 }
 
-func TestWriteIntSliceField(t *testing.T) {
+func TestReadFields(t *testing.T) {
 	t.Parallel()
 
-	field := lidarr.NewField()
-	field.SetName("set")
-	// use interface to simulate unmarshal response
-	field.SetValue(append(make([]interface{}, 0), 1, 2))
-
 	tests := map[string]struct {
-		fieldOutput lidarr.Field
-		set         []int64
-		written     Test
-		expected    Test
+		container Test
+		name      string
+		value     interface{}
 	}{
-		"working": {
-			fieldOutput: *field,
-			written:     Test{},
-			set:         []int64{1, 2},
-			expected:    Test{Set: types.SetValueMust(types.Int64Type, nil)},
+		"string": {
+			container: Test{Str: types.StringValue("String")},
+			name:      "str",
+			value:     "String",
+		},
+		"int": {
+			container: Test{In: types.Int64Value(55)},
+			name:      "in",
+			value:     int64(55),
+		},
+		"seed time": {
+			container: Test{SeedTime: types.Int64Value(55)},
+			name:      "seedCriteria.seedTime",
+			value:     int64(55),
+		},
+		"bool": {
+			container: Test{Boo: types.BoolValue(true)},
+			name:      "boo",
+			value:     true,
+		},
+		"float": {
+			container: Test{Fl: types.Float64Value(5.5)},
+			name:      "fl",
+			value:     5.5,
+		},
+		"string set": {
+			container: Test{Set: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test1"), types.StringValue("test2")})},
+			name:      "set",
+			value:     []string{"test1", "test2"},
+		},
+		"int set": {
+			container: Test{IntSet: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(9)})},
+			name:      "intSet",
+			value:     []int64{1, 9},
 		},
 	}
 	for name, test := range tests {
@@ -209,70 +363,69 @@ func TestWriteIntSliceField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			tfsdk.ValueFrom(context.Background(), test.set, test.expected.Set.Type(context.Background()), &test.expected.Set)
-			writeIntSliceField(context.Background(), &test.fieldOutput, &test.written)
-			assert.Equal(t, test.expected, test.written)
+			var diags diag.Diagnostics
+
+			test.container.Secret = types.StringValue("secret")
+
+			fields := test.container.readFields(context.Background(), &diags)
+			assert.False(t, diags.HasError())
+			assert.ElementsMatch(t, []lidarr.Field{setField(test.name, test.value), setField("secret", "secret")}, fields)
 		})
 	}
 }
 
-func TestWriteStringSliceField(t *testing.T) {
+func TestReadFieldsEmpty(t *testing.T) {
 	t.Parallel()
 
-	field := lidarr.NewField()
-	field.SetName("set")
-	// use interface to simulate unmarshal response
-	field.SetValue(append(make([]interface{}, 0), "test1", "test2"))
-
-	tests := map[string]struct {
-		fieldOutput lidarr.Field
-		set         []string
-		written     Test
-		expected    Test
-	}{
-		"working": {
-			fieldOutput: *field,
-			written:     Test{},
-			set:         []string{"test1", "test2"},
-			expected:    Test{Set: types.SetValueMust(types.StringType, nil)},
-		},
+	tests := map[string]Test{
+		"null":    emptyTest(),
+		"unknown": {Str: types.StringUnknown(), Secret: types.StringUnknown(), In: types.Int64Unknown(), Set: types.SetUnknown(types.StringType)},
+		"empty":   {Set: types.SetValueMust(types.StringType, nil), IntSet: types.SetValueMust(types.Int64Type, nil)},
 	}
-	for name, test := range tests {
-		test := test
+	for name, container := range tests {
+		container := container
 
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			tfsdk.ValueFrom(context.Background(), test.set, test.expected.Set.Type(context.Background()), &test.expected.Set)
-			writeStringSliceField(context.Background(), &test.fieldOutput, &test.written)
-			assert.Equal(t, test.expected, test.written)
+			var diags diag.Diagnostics
+
+			fields := container.readFields(context.Background(), &diags)
+			assert.False(t, diags.HasError())
+			assert.Empty(t, fields)
 		})
 	}
 }
 
-func TestReadStringField(t *testing.T) {
+func TestAppendMaskedField(t *testing.T) {
 	t.Parallel()
 
-	field := lidarr.NewField()
-	field.SetName("str")
-	field.SetValue("string")
-
 	tests := map[string]struct {
-		expected  lidarr.Field
-		name      string
-		fieldCase Test
+		value    types.String
+		prior    types.String
+		expected []lidarr.Field
 	}{
-		"working": {
-			fieldCase: Test{
-				Str: types.StringValue("string"),
-			},
-			name:     "str",
-			expected: *field,
+		"kept": {
+			value:    types.StringUnknown(),
+			prior:    types.StringValue("secret"),
+			expected: []lidarr.Field{setField("secret", SensitiveValue)},
 		},
-		"nil": {
-			fieldCase: Test{},
-			name:      "str",
-			expected:  *lidarr.NewField(),
+		"removed": {
+			value:    types.StringNull(),
+			prior:    types.StringValue("secret"),
+			expected: []lidarr.Field{setField("secret", SensitiveValue)},
+		},
+		"configured": {
+			value: types.StringValue("new"),
+			prior: types.StringValue("secret"),
+		},
+		"never set": {
+			value: types.StringUnknown(),
+			prior: types.StringNull(),
+		},
+		"empty": {
+			value: types.StringNull(),
+			prior: types.StringValue(""),
 		},
 	}
 	for name, test := range tests {
@@ -281,85 +434,84 @@ func TestReadStringField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			field := readStringField(test.name, &test.fieldCase)
-			assert.Equal(t, test.expected, field)
+			assert.Equal(t, test.expected, AppendMaskedField(nil, "secret", test.value, test.prior))
 		})
 	}
 }
 
-func TestReadIntField(t *testing.T) {
+func TestWriteFieldsErrors(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
-		name      string
-		tfName    string
-		fieldCase Test
-		value     int
+		field    lidarr.Field
+		expected string
 	}{
-		"working": {
-			fieldCase: Test{
-				In: types.Int64Value(10),
-			},
-			name:   "in",
-			tfName: "in",
-			value:  10,
+		"fractional int": {
+			field:    setField("in", 1.5),
+			expected: "field 'in' has value 1.5 of type float64, expected an integer",
 		},
-		"nil": {
-			fieldCase: Test{},
-			name:      "in",
-			tfName:    "in",
-			value:     0,
+		"string int": {
+			field:    setField("in", "1"),
+			expected: "field 'in' has value 1 of type string, expected an integer",
 		},
-		"seedtime": {
-			fieldCase: Test{
-				SeedTime: types.Int64Value(10),
-			},
-			name:   "seedCriteria.seedTime",
-			tfName: "seedTime",
-			value:  10,
+		"string bool": {
+			field:    setField("boo", "true"),
+			expected: "field 'boo' has value true of type string, expected a boolean",
+		},
+		"string float": {
+			field:    setField("fl", "1.5"),
+			expected: "field 'fl' has value 1.5 of type string, expected a number",
+		},
+		"object string": {
+			field:    setField("str", map[string]interface{}{}),
+			expected: "field 'str' has value map[] of type map[string]interface {}, expected a string",
+		},
+		"int in string set": {
+			field:    setField("set", []interface{}{float64(1)}),
+			expected: "field 'set' has value [1] of type []interface {}, expected a list of strings",
+		},
+		"float in int set": {
+			field:    setField("intSet", []interface{}{1.5}),
+			expected: "field 'intSet' has value [1.5] of type []interface {}, expected a list of integers",
+		},
+		"string as int set": {
+			field:    setField("intSet", "1"),
+			expected: "field 'intSet' has value 1 of type string, expected a list of integers",
 		},
 	}
 	for name, test := range tests {
 		test := test
 
-		expected := *lidarr.NewField()
-		if test.value != 0 {
-			expected.SetName(test.name)
-			expected.SetValue(int64(test.value))
-		}
-
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			field := readIntField(test.tfName, &test.fieldCase)
-			assert.Equal(t, expected, field)
+			var diags diag.Diagnostics
+
+			container := emptyTest()
+			container.writeFields([]lidarr.Field{test.field}, &diags)
+			assert.Equal(t, diag.Diagnostics{diag.NewErrorDiagnostic(FieldError, ParseFieldError(test.field.GetName(), errors.New(test.expected)))}, diags)
+			assert.Equal(t, emptyTest(), container)
 		})
 	}
 }
 
-func TestReadBoolField(t *testing.T) {
+func TestReadFieldsErrors(t *testing.T) {
 	t.Parallel()
 
-	field := lidarr.NewField()
-	field.SetName("boo")
-	field.SetValue(true)
-
 	tests := map[string]struct {
-		expected  lidarr.Field
+		container Test
 		name      string
-		fieldCase Test
+		expected  string
 	}{
-		"working": {
-			fieldCase: Test{
-				Boo: types.BoolValue(true),
-			},
-			name:     "boo",
-			expected: *field,
+		"wrong string set type": {
+			container: Test{Set: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)})},
+			name:      "set",
+			expected:  "attribute for field 'set' is not a set of strings",
 		},
-		"nil": {
-			fieldCase: Test{},
-			name:      "boo",
-			expected:  *lidarr.NewField(),
+		"wrong int set type": {
+			container: Test{IntSet: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")})},
+			name:      "intSet",
+			expected:  "attribute for field 'intSet' is not a set of integers",
 		},
 	}
 	for name, test := range tests {
@@ -368,120 +520,181 @@ func TestReadBoolField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			field := readBoolField(test.name, &test.fieldCase)
-			assert.Equal(t, test.expected, field)
+			var diags diag.Diagnostics
+
+			test.container.Secret = types.StringValue("secret")
+			fields := test.container.readFields(context.Background(), &diags)
+			assert.Equal(t, []lidarr.Field{setField("secret", "secret")}, fields)
+			assert.Equal(t, diag.Diagnostics{diag.NewErrorDiagnostic(FieldError, ParseFieldError(test.name, errors.New(test.expected)))}, diags)
 		})
 	}
 }
 
-func TestReadFloatField(t *testing.T) {
-	t.Parallel()
+// FuzzWriteFields checks that any API response is either mapped or reported, never panicking.
+func FuzzWriteFields(f *testing.F) {
+	f.Add([]byte(`[{"name":"in","value":1},{"name":"str","value":"test"},{"name":"set","value":["a","b"]}]`))
+	f.Add([]byte(`[{"name":"in","value":1.5},{"name":"boo","value":"true"},{"name":"fl","value":null}]`))
+	f.Add([]byte(`[{"name":"secret","value":"********"},{"name":"set","value":[1,{}]},{"name":"unknown","value":[]}]`))
+	f.Add([]byte(`[{"name":"seedCriteria.seedTime","value":1e300},{"name":"intSet","value":[1,2.5]}]`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var fields []lidarr.Field
+		if err := json.Unmarshal(data, &fields); err != nil {
+			t.Skip()
+		}
 
-	field := lidarr.NewField()
-	field.SetName("fl")
-	field.SetValue(3.5)
+		var diags diag.Diagnostics
 
-	tests := map[string]struct {
-		expected  lidarr.Field
-		name      string
-		fieldCase Test
-	}{
-		"working": {
-			fieldCase: Test{
-				Fl: types.Float64Value(3.5),
-			},
-			name:     "fl",
-			expected: *field,
-		},
-		"nil": {
-			fieldCase: Test{},
-			name:      "fl",
-			expected:  *lidarr.NewField(),
-		},
-	}
-	for name, test := range tests {
-		test := test
+		container := emptyTest()
+		container.writeFields(fields, &diags)
 
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
+		if diags.HasError() {
+			return
+		}
 
-			field := readFloatField(test.name, &test.fieldCase)
-			assert.Equal(t, test.expected, field)
-		})
-	}
+		// Whatever was written must be readable back.
+		container.readFields(context.Background(), &diags)
+		assert.False(t, diags.HasError())
+	})
 }
 
-func TestReadStringSliceField(t *testing.T) {
-	t.Parallel()
+// FuzzReadFields checks that values survive a round trip through the API representation.
+func FuzzReadFields(f *testing.F) {
+	f.Add("string", "secret", int32(1), true, 1.5, "element")
+	f.Add("", "", int32(-1), false, 0.0, "")
+	f.Add("text", SensitiveValue, int32(0), false, -1e10, "a")
 
-	field := lidarr.NewField()
-	field.SetName("set")
-	field.SetValue([]string{"test1", "test2"})
+	f.Fuzz(func(t *testing.T, str, secret string, in int32, boo bool, fl float64, element string) {
+		if math.IsNaN(fl) || math.IsInf(fl, 0) || !utf8.ValidString(str) || !utf8.ValidString(secret) || !utf8.ValidString(element) || str == SensitiveValue {
+			t.Skip()
+		}
 
-	tests := map[string]struct {
-		expected  lidarr.Field
-		name      string
-		set       []string
-		fieldCase Test
-	}{
-		"working": {
-			fieldCase: Test{
-				Set: types.SetValueMust(types.StringType, nil),
-			},
-			name:     "set",
-			expected: *field,
-			set:      []string{"test1", "test2"},
-		},
-		"nil": {
-			fieldCase: Test{
-				Set: types.SetValueMust(types.StringType, nil),
-			},
-			name:     "set",
-			expected: *lidarr.NewField(),
-			set:      []string{},
-		},
-	}
-	for name, test := range tests {
-		test := test
+		var diags diag.Diagnostics
+
+		container := Test{
+			Str:      types.StringValue(str),
+			Secret:   types.StringValue(secret),
+			In:       types.Int64Value(int64(in)),
+			SeedTime: types.Int64Value(int64(in)),
+			Boo:      types.BoolValue(boo),
+			Fl:       types.Float64Value(fl),
+			Set:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue(element)}),
+			IntSet:   types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(int64(in))}),
+		}
+		fields := container.readFields(context.Background(), &diags)
+		assert.False(t, diags.HasError())
 
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
+		// Simulate the API serialization.
+		data, err := json.Marshal(fields)
+		assert.NoError(t, err)
 
-			tfsdk.ValueFrom(context.Background(), test.set, test.fieldCase.Set.Type(context.Background()), &test.fieldCase.Set)
-			field := readStringSliceField(context.Background(), test.name, &test.fieldCase)
-			assert.Equal(t, test.expected, field)
-		})
-	}
+		fields = nil
+		assert.NoError(t, json.Unmarshal(data, &fields))
+
+		// Sensitive values masked or empty are kept from the known value.
+		written := emptyTest()
+		if secret == SensitiveValue || secret == "" {
+			written.Secret = container.Secret
+		}
+
+		written.writeFields(fields, &diags)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, container, written)
+	})
 }
 
-func TestReadIntSliceField(t *testing.T) {
+func TestSchemaFieldValue(t *testing.T) {
 	t.Parallel()
 
-	field := lidarr.NewField()
-	field.SetName("set")
-	field.SetValue([]int64{1, 2})
+	isFloat := lidarr.NewField()
+	isFloat.SetType("number")
+	isFloat.SetIsFloat(true)
 
 	tests := map[string]struct {
-		expected  lidarr.Field
-		name      string
-		set       []int64
-		fieldCase Test
+		field    *lidarr.Field
+		value    string
+		expected interface{}
+		err      string
 	}{
-		"working": {
-			fieldCase: Test{
-				Set: types.SetValueMust(types.Int64Type, nil),
-			},
-			name:     "set",
-			expected: *field,
-			set:      []int64{1, 2},
+		"textbox": {
+			field:    &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("textbox"))},
+			value:    "123",
+			expected: "123",
+		},
+		"checkbox": {
+			field:    &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("checkbox"))},
+			value:    "true",
+			expected: true,
+		},
+		"invalid checkbox": {
+			field: &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("checkbox"))},
+			value: "yes",
+			err:   "value 'yes' is not a boolean",
+		},
+		"number": {
+			field:    &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("number"))},
+			value:    "5",
+			expected: int64(5),
+		},
+		"fractional number": {
+			field: &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("number"))},
+			value: "5.5",
+			err:   "value '5.5' is not an integer",
 		},
-		"nil": {
-			fieldCase: Test{
-				Set: types.SetValueMust(types.Int64Type, nil),
-			},
-			name:     "set",
-			expected: *lidarr.NewField(),
-			set:      []int64{},
+		"float": {
+			field:    isFloat,
+			value:    "5.5",
+			expected: 5.5,
+		},
+		"invalid number": {
+			field: &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("number"))},
+			value: "five",
+			err:   "value 'five' is not a number",
+		},
+		"select": {
+			field:    &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("select"))},
+			value:    "2",
+			expected: int64(2),
+		},
+		"multiple select": {
+			field:    &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("select"))},
+			value:    "[1,2]",
+			expected: []interface{}{float64(1), float64(2)},
+		},
+		"string select": {
+			field:    &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("select"))},
+			value:    "device",
+			expected: "device",
+		},
+		"tag": {
+			field:    &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("tag"))},
+			value:    `["a", "b"]`,
+			expected: []interface{}{"a", "b"},
+		},
+		"invalid tag": {
+			field: &lidarr.Field{Type: *lidarr.NewNullableString(lidarr.PtrString("tag"))},
+			value: "a,b",
+			err:   "value 'a,b' is not a JSON list",
+		},
+		"inferred bool": {
+			field:    &lidarr.Field{Value: false},
+			value:    "false",
+			expected: false,
+		},
+		"inferred number": {
+			field:    &lidarr.Field{Value: float64(0)},
+			value:    "2.5",
+			expected: 2.5,
+		},
+		"inferred list": {
+			field:    &lidarr.Field{Value: []interface{}{}},
+			value:    "[]",
+			expected: []interface{}{},
+		},
+		"inferred string": {
+			field:    &lidarr.Field{},
+			value:    "text",
+			expected: "text",
 		},
 	}
 	for name, test := range tests {
@@ -490,170 +703,66 @@ func TestReadIntSliceField(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			tfsdk.ValueFrom(context.Background(), test.set, test.fieldCase.Set.Type(context.Background()), &test.fieldCase.Set)
-			field := readIntSliceField(context.Background(), test.name, &test.fieldCase)
-			assert.Equal(t, test.expected, field)
+			value, err := SchemaFieldValue(test.field, test.value)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
 		})
 	}
 }
 
-func TestReadFields(t *testing.T) {
+func TestFieldValueString(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
-		fieldLists Fields
-		name       string
-		value      interface{}
-		testData   Test
+		value    interface{}
+		expected string
 	}{
-		"string": {
-			fieldLists: Fields{Strings: []string{"str"}},
-			name:       "str",
-			value:      "String",
-			testData:   Test{Str: types.StringValue("String")},
-		},
-		"int": {
-			fieldLists: Fields{Ints: []string{"in"}},
-			name:       "in",
-			value:      int64(55),
-			testData:   Test{In: types.Int64Value(55)},
-		},
-		"bool": {
-			fieldLists: Fields{Bools: []string{"boo"}},
-			name:       "boo",
-			value:      true,
-			testData:   Test{Boo: types.BoolValue(true)},
-		},
-		"float": {
-			fieldLists: Fields{Floats: []string{"fl"}},
-			name:       "fl",
-			value:      5.5,
-			testData:   Test{Fl: types.Float64Value(5.5)},
-		},
-		"stringSlice": {
-			fieldLists: Fields{StringSlices: []string{"set"}},
-			name:       "set",
-			value:      []string{"test1", "test2"},
-			testData:   Test{Set: types.SetValueMust(types.StringType, nil)},
-		},
-		"intSlice": {
-			fieldLists: Fields{IntSlices: []string{"set"}},
-			name:       "set",
-			value:      []int64{1, 9},
-			testData:   Test{Set: types.SetValueMust(types.Int64Type, nil)},
-		},
+		"nil":    {value: nil, expected: ""},
+		"string": {value: "text", expected: "text"},
+		"bool":   {value: true, expected: "true"},
+		"int":    {value: float64(5), expected: "5"},
+		"float":  {value: 1.5, expected: "1.5"},
+		"list":   {value: []interface{}{"a", float64(1)}, expected: `["a",1]`},
+		"object": {value: map[string]interface{}{"key": "value"}, expected: `{"key":"value"}`},
 	}
 	for name, test := range tests {
 		test := test
 
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-
-			if test.name == "set" {
-				tfsdk.ValueFrom(context.Background(), test.value, test.testData.Set.Type(context.Background()), &test.testData.Set)
-			}
-
-			expectedFields := make([]lidarr.Field, 1)
-			expectedFields[0] = *lidarr.NewField()
-			expectedFields[0].SetName(test.name)
-			expectedFields[0].SetValue(test.value)
-
-			fields := ReadFields(context.Background(), &test.testData, test.fieldLists)
-			assert.Equal(t, &expectedFields, &fields)
+			assert.Equal(t, test.expected, FieldValueString(test.value))
 		})
 	}
 }
 
-func TestWriteFields(t *testing.T) {
+func TestSameFieldValue(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
-		fieldLists     Fields
-		name           string
-		value          interface{}
-		fieldContainer Test
+		value    string
+		apiValue interface{}
+		expected bool
 	}{
-		"string": {
-			fieldLists:     Fields{Strings: []string{"str"}},
-			name:           "str",
-			value:          "String",
-			fieldContainer: Test{Str: types.StringValue("String")},
-		},
-		"int": {
-			fieldLists:     Fields{Ints: []string{"in"}},
-			name:           "in",
-			value:          float64(55),
-			fieldContainer: Test{In: types.Int64Value(55)},
-		},
-		"bool": {
-			fieldLists:     Fields{Bools: []string{"boo"}},
-			name:           "boo",
-			value:          true,
-			fieldContainer: Test{Boo: types.BoolValue(true)},
-		},
-		"float": {
-			fieldLists:     Fields{Floats: []string{"fl"}},
-			name:           "fl",
-			value:          5.5,
-			fieldContainer: Test{Fl: types.Float64Value(5.5)},
-		},
-		"stringSlice": {
-			fieldLists:     Fields{StringSlices: []string{"set"}},
-			name:           "set",
-			value:          append(make([]interface{}, 0), []string{"test1", "test2"}),
-			fieldContainer: Test{Set: types.SetValueMust(types.StringType, nil)},
-		},
-		"intSlice": {
-			fieldLists:     Fields{IntSlices: []string{"set"}},
-			name:           "set",
-			value:          append(make([]interface{}, 0), []float64{1, 9}),
-			fieldContainer: Test{Set: types.SetValueMust(types.Int64Type, nil)},
-		},
-		"intSliceException": {
-			fieldLists:     Fields{IntSlicesExceptions: []string{"set"}},
-			name:           "set",
-			value:          append(make([]interface{}, 0), []float64{1, 9}),
-			fieldContainer: Test{Set: types.SetValueMust(types.Int64Type, nil)},
-		},
-		"stringSliceException": {
-			fieldLists:     Fields{StringSlicesExceptions: []string{"set"}},
-			name:           "set",
-			value:          append(make([]interface{}, 0), []string{"test1", "test2"}),
-			fieldContainer: Test{Set: types.SetValueMust(types.StringType, nil)},
-		},
-		"sensitive": {
-			fieldLists:     Fields{Strings: []string{"str"}},
-			name:           "str",
-			value:          SensitiveValue,
-			fieldContainer: Test{Str: types.StringValue("String")},
-		},
+		"same string":      {value: "text", apiValue: "text", expected: true},
+		"different string": {value: "text", apiValue: "other", expected: false},
+		"number format":    {value: "1.50", apiValue: 1.5, expected: true},
+		"list spacing":     {value: `[ "a", "b" ]`, apiValue: []interface{}{"a", "b"}, expected: true},
+		"list order":       {value: `["b","a"]`, apiValue: []interface{}{"a", "b"}, expected: false},
+		"bool":             {value: "true", apiValue: true, expected: true},
+		"changed number":   {value: "2", apiValue: float64(1), expected: false},
 	}
-
 	for name, test := range tests {
 		test := test
 
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-
-			if test.name == "set" {
-				tfsdk.ValueFrom(context.Background(), test.value, test.fieldContainer.Set.Type(context.Background()), &test.fieldContainer.Set)
-			}
-
-			fields := make([]lidarr.Field, 1)
-			fields[0] = *lidarr.NewField()
-			fields[0].SetName(test.name)
-			fields[0].SetValue(test.value)
-
-			container := Test{}
-			if test.value == SensitiveValue {
-				// emulate the sensitive behaviour
-				container = Test{
-					Str: types.StringValue("String"),
-				}
-			}
-
-			WriteFields(context.TODO(), &container, fields, test.fieldLists)
-			assert.Equal(t, &test.fieldContainer, &container)
+			assert.Equal(t, test.expected, SameFieldValue(test.value, test.apiValue))
 		})
 	}
 }
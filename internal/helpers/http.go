@@ -0,0 +1,163 @@
+package helpers
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type requestMethodKey struct{}
+
+// NewAuthContext returns the context to be used by API calls, with API key and server variables.
+func NewAuthContext(ctx context.Context, apiURL *url.URL, key string) context.Context {
+	auth := context.WithValue(
+		ctx,
+		lidarr.ContextAPIKeys,
+		map[string]lidarr.APIKey{
+			"X-Api-Key": {Key: key},
+		},
+	)
+
+	return context.WithValue(auth, lidarr.ContextServerVariables, map[string]string{
+		"protocol": apiURL.Scheme,
+		"hostpath": apiURL.Host,
+	})
+}
+
+// HTTPOptions describes the HTTP client connection options.
+type HTTPOptions struct {
+	CACertificate      string
+	ClientCertificate  string
+	ClientKey          string
+	Timeout            time.Duration
+	InsecureSkipVerify bool
+}
+
+// NewHTTPClient returns an HTTP client configured with timeout and TLS options.
+// Certificates and keys can be either PEM encoded values or file paths.
+func NewHTTPClient(options HTTPOptions) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		//nolint:gosec // explicitly requested by the user
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+
+	if options.CACertificate != "" {
+		ca, err := readPEM(options.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.New("unable to parse CA certificate")
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if options.ClientCertificate != "" || options.ClientKey != "" {
+		if options.ClientCertificate == "" || options.ClientKey == "" {
+			return nil, errors.New("both client certificate and client key must be provided")
+		}
+
+		cert, err := readPEM(options.ClientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %w", err)
+		}
+
+		key, err := readPEM(options.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client key: %w", err)
+		}
+
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse client certificate: %w", err)
+		}
+
+		transport.TLSClientConfig.Certificates = []tls.Certificate{pair}
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   options.Timeout,
+	}, nil
+}
+
+// readPEM returns the PEM value as is, or reads it from file.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
+
+// NewRetryableHTTPClient wraps the given client with exponential backoff retries.
+// Idempotent requests are retried on connection errors, 429 and 5xx responses,
+// while the others are retried only if the connection was refused.
+func NewRetryableHTTPClient(client *http.Client, maxRetries int, waitMin, waitMax time.Duration) *http.Client {
+	retryClient := retryablehttp.NewClient()
+	retryClient.HTTPClient = client
+	retryClient.RetryMax = maxRetries
+	retryClient.RetryWaitMin = waitMin
+	retryClient.RetryWaitMax = waitMax
+	retryClient.CheckRetry = retryPolicy
+	// Return the last response as is, so that the API error can be parsed.
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	retryClient.Logger = nil
+	retryClient.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, attempt int) {
+		if attempt > 0 {
+			tflog.Warn(req.Context(), fmt.Sprintf("retrying %s %s (attempt %d/%d)", req.Method, req.URL.Path, attempt, maxRetries))
+		}
+	}
+
+	return &http.Client{
+		Transport: &methodRoundTripper{next: &retryablehttp.RoundTripper{Client: retryClient}},
+		// Timeout is applied to each attempt by the inner client.
+		CheckRedirect: client.CheckRedirect,
+		Jar:           client.Jar,
+	}
+}
+
+// methodRoundTripper stores the request method in context, since it is not available to the retry policy.
+type methodRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (t *methodRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(context.WithValue(req.Context(), requestMethodKey{}, req.Method)))
+}
+
+func retryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	retry, policyErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if !retry {
+		return false, policyErr
+	}
+
+	switch method, _ := ctx.Value(requestMethodKey{}).(string); method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true, nil
+	default:
+		// The request never reached the server.
+		return errors.Is(err, syscall.ECONNREFUSED), nil
+	}
+}
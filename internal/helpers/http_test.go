@@ -0,0 +1,182 @@
+package helpers
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRetryableHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method   string
+		statuses []int
+		expected int
+		attempts int32
+	}{
+		"get recovered": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK},
+			expected: http.StatusOK,
+			attempts: 3,
+		},
+		"get exhausted": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusBadGateway},
+			expected: http.StatusBadGateway,
+			attempts: 3,
+		},
+		"put recovered": {
+			method:   http.MethodPut,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			expected: http.StatusOK,
+			attempts: 2,
+		},
+		"post not retried": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusBadGateway, http.StatusOK},
+			expected: http.StatusBadGateway,
+			attempts: 1,
+		},
+		"client error not retried": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusNotFound, http.StatusOK},
+			expected: http.StatusNotFound,
+			attempts: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := int(attempts.Add(1)) - 1
+				w.WriteHeader(test.statuses[min(attempt, len(test.statuses)-1)])
+			}))
+			defer server.Close()
+
+			client := NewRetryableHTTPClient(&http.Client{}, 2, time.Millisecond, time.Millisecond)
+			req, _ := http.NewRequest(test.method, server.URL, strings.NewReader("{}"))
+
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, resp.StatusCode)
+			assert.Equal(t, test.attempts, attempts.Load())
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestNewRetryableHTTPClientConnectionRefused(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	client := NewRetryableHTTPClient(&http.Client{Transport: &countingRoundTripper{next: http.DefaultTransport, count: &attempts}}, 2, time.Millisecond, time.Millisecond)
+	req, _ := http.NewRequest(http.MethodPost, url, strings.NewReader("{}"))
+
+	_, err := client.Do(req)
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+type countingRoundTripper struct {
+	next  http.RoundTripper
+	count *atomic.Int32
+}
+
+func (t *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count.Add(1)
+
+	return t.next.RoundTrip(req)
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(caFile, []byte(ca), 0o600))
+
+	tests := map[string]struct {
+		options   HTTPOptions
+		configErr string
+		requestOK bool
+	}{
+		"default": {
+			options:   HTTPOptions{},
+			requestOK: false,
+		},
+		"ca pem": {
+			options:   HTTPOptions{CACertificate: ca, Timeout: time.Second},
+			requestOK: true,
+		},
+		"ca file": {
+			options:   HTTPOptions{CACertificate: caFile},
+			requestOK: true,
+		},
+		"insecure": {
+			options:   HTTPOptions{InsecureSkipVerify: true},
+			requestOK: true,
+		},
+		"invalid ca": {
+			options:   HTTPOptions{CACertificate: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----"},
+			configErr: "unable to parse CA certificate",
+		},
+		"missing ca file": {
+			options:   HTTPOptions{CACertificate: filepath.Join(t.TempDir(), "missing.pem")},
+			configErr: "unable to read CA certificate",
+		},
+		"missing client key": {
+			options:   HTTPOptions{ClientCertificate: ca},
+			configErr: "both client certificate and client key must be provided",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewHTTPClient(test.options)
+			if test.configErr != "" {
+				assert.ErrorContains(t, err, test.configErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.options.Timeout, client.Timeout)
+
+			resp, err := client.Get(server.URL)
+			if !test.requestOK {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			resp.Body.Close()
+		})
+	}
+}
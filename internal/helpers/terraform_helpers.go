@@ -2,8 +2,10 @@ package helpers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,3 +34,72 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
+
+// ImportNamePrefix is the import identifier prefix to import a resource by name.
+const ImportNamePrefix = "name:"
+
+// ImportStatePassthroughIntIDOrName is like ImportStatePassthroughIntID, but it
+// also accepts a `name:<name>` import identifier, resolved to the ID by lookup.
+func ImportStatePassthroughIntIDOrName(ctx context.Context, attrPath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse, lookup func(name string) (int32, error)) {
+	name, found := strings.CutPrefix(req.ID, ImportNamePrefix)
+	if !found {
+		if _, err := strconv.Atoi(req.ID); err != nil {
+			resp.Diagnostics.AddError(
+				UnexpectedImportIdentifier,
+				fmt.Sprintf("Expected import identifier with format: ID or %s<name>. Got: %s", ImportNamePrefix, req.ID),
+			)
+
+			return
+		}
+
+		ImportStatePassthroughIntID(ctx, attrPath, req, resp)
+
+		return
+	}
+
+	id, err := lookup(name)
+	if err != nil {
+		resp.Diagnostics.AddError(UnexpectedImportIdentifier, err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, int64(id))...)
+}
+
+// FindIDByName returns the ID of the only item matching the given name.
+func FindIDByName[T any](kind, field, name string, items []T, getName func(*T) string, getID func(*T) int32) (int32, error) {
+	var ids []int32
+
+	for i := range items {
+		if getName(&items[i]) == name {
+			ids = append(ids, getID(&items[i]))
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return 0, errors.New(ParseNotFoundError(kind, field, name))
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, fmt.Errorf("found %d %s with %s '%s', import it by ID instead", len(ids), kind, field, name)
+	}
+}
+
+// FindImplementationIDByName returns the ID of the only item matching the given name,
+// which must be of the given implementation, unless empty.
+func FindImplementationIDByName[T any](kind, name, implementation string, items []T, getName, getImplementation func(*T) string, getID func(*T) int32) (int32, error) {
+	id, err := FindIDByName(kind, "name", name, items, getName, getID)
+	if err != nil || implementation == "" {
+		return id, err
+	}
+
+	for i := range items {
+		if getID(&items[i]) == id && getImplementation(&items[i]) != implementation {
+			return 0, fmt.Errorf("%s with name '%s' is a %s implementation, not %s", kind, name, getImplementation(&items[i]), implementation)
+		}
+	}
+
+	return id, nil
+}
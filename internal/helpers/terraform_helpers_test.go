@@ -0,0 +1,108 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindIDByName(t *testing.T) {
+	t.Parallel()
+
+	tags := []lidarr.TagResource{
+		{Id: lidarr.PtrInt32(1), Label: *lidarr.NewNullableString(lidarr.PtrString("flac"))},
+		{Id: lidarr.PtrInt32(2), Label: *lidarr.NewNullableString(lidarr.PtrString("mp3"))},
+		{Id: lidarr.PtrInt32(3), Label: *lidarr.NewNullableString(lidarr.PtrString("mp3"))},
+	}
+
+	tests := map[string]struct {
+		name     string
+		expected int32
+		err      string
+	}{
+		"found": {
+			name:     "flac",
+			expected: 1,
+		},
+		"not found": {
+			name: "ogg",
+			err:  "Unable to find tag, got error: data source not found: no tag with label 'ogg'",
+		},
+		"ambiguous": {
+			name: "mp3",
+			err:  "found 2 tag with label 'mp3', import it by ID instead",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := FindIDByName("tag", "label", test.name, tags, (*lidarr.TagResource).GetLabel, (*lidarr.TagResource).GetId)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
+
+func TestFindImplementationIDByName(t *testing.T) {
+	t.Parallel()
+
+	indexers := []lidarr.IndexerResource{
+		{Id: lidarr.PtrInt32(1), Name: *lidarr.NewNullableString(lidarr.PtrString("usenet")), Implementation: *lidarr.NewNullableString(lidarr.PtrString("Newznab"))},
+		{Id: lidarr.PtrInt32(2), Name: *lidarr.NewNullableString(lidarr.PtrString("torrent")), Implementation: *lidarr.NewNullableString(lidarr.PtrString("Torznab"))},
+	}
+
+	tests := map[string]struct {
+		name           string
+		implementation string
+		expected       int32
+		err            string
+	}{
+		"found": {
+			name:           "usenet",
+			implementation: "Newznab",
+			expected:       1,
+		},
+		"any implementation": {
+			name:     "torrent",
+			expected: 2,
+		},
+		"other implementation": {
+			name:           "torrent",
+			implementation: "Newznab",
+			err:            "indexer with name 'torrent' is a Torznab implementation, not Newznab",
+		},
+		"not found": {
+			name:           "missing",
+			implementation: "Newznab",
+			err:            "Unable to find indexer, got error: data source not found: no indexer with name 'missing'",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := FindImplementationIDByName("indexer", test.name, test.implementation, indexers,
+				(*lidarr.IndexerResource).GetName, (*lidarr.IndexerResource).GetImplementation, (*lidarr.IndexerResource).GetId)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
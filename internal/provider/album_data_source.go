@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const albumDataSourceName = "album"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AlbumDataSource{}
+
+func NewAlbumDataSource() datasource.DataSource {
+	return &AlbumDataSource{}
+}
+
+// AlbumDataSource defines the album implementation.
+type AlbumDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+func (d *AlbumDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + albumDataSourceName
+}
+
+func (d *AlbumDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Albums -->\nSingle [Album](../resources/album).\nIt can be searched by `id`, `foreign_album_id` or `title` and `artist_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Album ID.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("foreign_album_id"), path.MatchRoot("title")),
+				},
+			},
+			"foreign_album_id": schema.StringAttribute{
+				MarkdownDescription: "Foreign album ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Album title. It requires `artist_id`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("artist_id")),
+				},
+			},
+			"artist_id": schema.Int64Attribute{
+				MarkdownDescription: "Artist ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"foreign_release_id": schema.StringAttribute{
+				MarkdownDescription: "Foreign ID of the selected release.",
+				Computed:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Computed:            true,
+			},
+			"any_release_ok": schema.BoolAttribute{
+				MarkdownDescription: "Any release OK flag.",
+				Computed:            true,
+			},
+			"disambiguation": schema.StringAttribute{
+				MarkdownDescription: "Disambiguation.",
+				Computed:            true,
+			},
+			"album_type": schema.StringAttribute{
+				MarkdownDescription: "Album type.",
+				Computed:            true,
+			},
+			"release_date": schema.StringAttribute{
+				MarkdownDescription: "Release date.",
+				Computed:            true,
+			},
+			"genres": schema.SetAttribute{
+				MarkdownDescription: "List genres.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"secondary_types": schema.SetAttribute{
+				MarkdownDescription: "Secondary types.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"statistics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Album statistics.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"track_file_count": schema.Int64Attribute{
+						MarkdownDescription: "Track file count.",
+						Computed:            true,
+					},
+					"track_count": schema.Int64Attribute{
+						MarkdownDescription: "Track count.",
+						Computed:            true,
+					},
+					"total_track_count": schema.Int64Attribute{
+						MarkdownDescription: "Total track count.",
+						Computed:            true,
+					},
+					"size_on_disk": schema.Int64Attribute{
+						MarkdownDescription: "Size on disk.",
+						Computed:            true,
+					},
+					"percent_of_tracks": schema.Float64Attribute{
+						MarkdownDescription: "Percent of tracks.",
+						Computed:            true,
+					},
+				},
+			},
+			"releases": schema.SetNestedAttribute{
+				MarkdownDescription: "Album releases.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Release ID.",
+							Computed:            true,
+						},
+						"foreign_release_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign release ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Release status.",
+							Computed:            true,
+						},
+						"format": schema.StringAttribute{
+							MarkdownDescription: "Release format.",
+							Computed:            true,
+						},
+						"disambiguation": schema.StringAttribute{
+							MarkdownDescription: "Disambiguation.",
+							Computed:            true,
+						},
+						"track_count": schema.Int64Attribute{
+							MarkdownDescription: "Track count.",
+							Computed:            true,
+						},
+						"medium_count": schema.Int64Attribute{
+							MarkdownDescription: "Medium count.",
+							Computed:            true,
+						},
+						"duration": schema.Int64Attribute{
+							MarkdownDescription: "Duration.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+						"country": schema.SetAttribute{
+							MarkdownDescription: "Countries.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"label": schema.SetAttribute{
+							MarkdownDescription: "Labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AlbumDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *AlbumDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Album
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get albums current value
+	request := d.client.AlbumAPI.ListAlbum(d.auth)
+
+	switch {
+	case !data.ID.IsNull():
+		request = request.AlbumIds([]int32{int32(data.ID.ValueInt64())})
+	case !data.ForeignAlbumID.IsNull():
+		request = request.ForeignAlbumId(data.ForeignAlbumID.ValueString())
+	default:
+		request = request.ArtistId(int32(data.ArtistID.ValueInt64()))
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, albumDataSourceName, err))
+
+		return
+	}
+
+	data.find(ctx, response, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+albumDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (a *Album) find(ctx context.Context, albums []lidarr.AlbumResource, diags *diag.Diagnostics) {
+	field, search := "title", a.Title.ValueString()
+
+	switch {
+	case !a.ID.IsNull():
+		field, search = "ID", strconv.Itoa(int(a.ID.ValueInt64()))
+	case !a.ForeignAlbumID.IsNull():
+		field, search = "foreign album ID", a.ForeignAlbumID.ValueString()
+	}
+
+	for _, album := range albums {
+		if (!a.ID.IsNull() && int64(album.GetId()) == a.ID.ValueInt64()) ||
+			(!a.ForeignAlbumID.IsNull() && album.GetForeignAlbumId() == a.ForeignAlbumID.ValueString()) ||
+			(!a.Title.IsNull() && strings.EqualFold(album.GetTitle(), a.Title.ValueString())) {
+			a.write(ctx, &album, diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(albumDataSourceName, field, search))
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlbumDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccLivePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccAlbumDataSourceConfig("foreign_album_id = \"999\"") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccAlbumDataSourceConfig("foreign_album_id = \"999\""),
+				ExpectError: regexp.MustCompile("Unable to find album"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccAlbumDataSourceArtistConfig + testAccAlbumDataSourceConfig("title = \"Dookie\"\nartist_id = lidarr_artist.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lidarr_album.test", "id"),
+					resource.TestCheckResourceAttr("data.lidarr_album.test", "album_type", "Album"),
+					resource.TestCheckResourceAttrSet("data.lidarr_album.test", "releases.#"),
+					resource.TestCheckResourceAttrSet("data.lidarr_album.test", "statistics.total_track_count"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAlbumDataSourceArtistConfig = `
+resource "lidarr_artist" "test" {
+	monitored = false
+	artist_name = "Green Day"
+	root_folder_path = "/config"
+	quality_profile_id = 1
+	metadata_profile_id = 1
+	foreign_artist_id = "084308bd-1654-436f-ba03-df6697104e19"
+	add_options = {
+		monitor = "none"
+		search_for_missing_albums = false
+	}
+}
+`
+
+func testAccAlbumDataSourceConfig(search string) string {
+	return fmt.Sprintf(`
+	data "lidarr_album" "test" {
+		%s
+	}
+	`, search)
+}
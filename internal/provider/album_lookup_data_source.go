@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const albumLookupDataSourceName = "album_lookup"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AlbumLookupDataSource{}
+
+func NewAlbumLookupDataSource() datasource.DataSource {
+	return &AlbumLookupDataSource{}
+}
+
+// AlbumLookupDataSource defines the album lookup implementation.
+type AlbumLookupDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// AlbumLookup describes the album lookup data model.
+type AlbumLookup struct {
+	Albums types.List   `tfsdk:"albums"`
+	Term   types.String `tfsdk:"term"`
+	ID     types.String `tfsdk:"id"`
+}
+
+// AlbumLookupCandidate describes the album lookup candidate data model.
+type AlbumLookupCandidate struct {
+	SecondaryTypes  types.Set    `tfsdk:"secondary_types"`
+	Releases        types.Set    `tfsdk:"releases"`
+	ForeignAlbumID  types.String `tfsdk:"foreign_album_id"`
+	Title           types.String `tfsdk:"title"`
+	Disambiguation  types.String `tfsdk:"disambiguation"`
+	AlbumType       types.String `tfsdk:"album_type"`
+	ReleaseDate     types.String `tfsdk:"release_date"`
+	ArtistName      types.String `tfsdk:"artist_name"`
+	ForeignArtistID types.String `tfsdk:"foreign_artist_id"`
+	ID              types.Int64  `tfsdk:"id"`
+	ArtistID        types.Int64  `tfsdk:"artist_id"`
+}
+
+func (c AlbumLookupCandidate) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"secondary_types":   types.SetType{}.WithElementType(types.StringType),
+			"releases":          types.SetType{}.WithElementType(AlbumRelease{}.getType()),
+			"foreign_album_id":  types.StringType,
+			"title":             types.StringType,
+			"disambiguation":    types.StringType,
+			"album_type":        types.StringType,
+			"release_date":      types.StringType,
+			"artist_name":       types.StringType,
+			"foreign_artist_id": types.StringType,
+			"id":                types.Int64Type,
+			"artist_id":         types.Int64Type,
+		})
+}
+
+func (d *AlbumLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + albumLookupDataSourceName
+}
+
+func (d *AlbumLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Albums -->\nSearch [Albums](../resources/album) candidates through the metadata provider, ordered by relevance.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"term": schema.StringAttribute{
+				MarkdownDescription: "Search term. It can be an album title, optionally with the artist name, or a `lidarr:`/`mbid:` prefixed foreign album ID.",
+				Required:            true,
+			},
+			"albums": schema.ListNestedAttribute{
+				MarkdownDescription: "Album candidates.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Album ID. It is `0` if the album is not in the library.",
+							Computed:            true,
+						},
+						"foreign_album_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign album ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Album title.",
+							Computed:            true,
+						},
+						"disambiguation": schema.StringAttribute{
+							MarkdownDescription: "Disambiguation.",
+							Computed:            true,
+						},
+						"album_type": schema.StringAttribute{
+							MarkdownDescription: "Album type.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "Release date.",
+							Computed:            true,
+						},
+						"artist_id": schema.Int64Attribute{
+							MarkdownDescription: "Artist ID. It is `0` if the artist is not in the library.",
+							Computed:            true,
+						},
+						"artist_name": schema.StringAttribute{
+							MarkdownDescription: "Artist name.",
+							Computed:            true,
+						},
+						"foreign_artist_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign artist ID.",
+							Computed:            true,
+						},
+						"secondary_types": schema.SetAttribute{
+							MarkdownDescription: "Secondary types.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"releases": schema.SetNestedAttribute{
+							MarkdownDescription: "Album releases.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.Int64Attribute{
+										MarkdownDescription: "Release ID.",
+										Computed:            true,
+									},
+									"foreign_release_id": schema.StringAttribute{
+										MarkdownDescription: "Foreign release ID.",
+										Computed:            true,
+									},
+									"title": schema.StringAttribute{
+										MarkdownDescription: "Release title.",
+										Computed:            true,
+									},
+									"status": schema.StringAttribute{
+										MarkdownDescription: "Release status.",
+										Computed:            true,
+									},
+									"format": schema.StringAttribute{
+										MarkdownDescription: "Release format.",
+										Computed:            true,
+									},
+									"disambiguation": schema.StringAttribute{
+										MarkdownDescription: "Disambiguation.",
+										Computed:            true,
+									},
+									"track_count": schema.Int64Attribute{
+										MarkdownDescription: "Track count.",
+										Computed:            true,
+									},
+									"medium_count": schema.Int64Attribute{
+										MarkdownDescription: "Medium count.",
+										Computed:            true,
+									},
+									"duration": schema.Int64Attribute{
+										MarkdownDescription: "Duration.",
+										Computed:            true,
+									},
+									"monitored": schema.BoolAttribute{
+										MarkdownDescription: "Monitored flag.",
+										Computed:            true,
+									},
+									"country": schema.SetAttribute{
+										MarkdownDescription: "Countries.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+									"label": schema.SetAttribute{
+										MarkdownDescription: "Labels.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AlbumLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *AlbumLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *AlbumLookup
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get album lookup current value
+	response, _, err := d.client.AlbumLookupAPI.ListAlbumLookup(d.auth).Term(data.Term.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, albumLookupDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+albumLookupDataSourceName)
+	// Map response body to resource schema attribute
+	albums := make([]AlbumLookupCandidate, len(response))
+	for i, a := range response {
+		albums[i].write(ctx, &a, &resp.Diagnostics)
+	}
+
+	albumList, diags := types.ListValueFrom(ctx, AlbumLookupCandidate{}.getType(), albums)
+	resp.Diagnostics.Append(diags...)
+
+	data.Albums = albumList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (c *AlbumLookupCandidate) write(ctx context.Context, album *lidarr.AlbumResource, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	artist := album.GetArtist()
+
+	c.ID = types.Int64Value(int64(album.GetId()))
+	c.ForeignAlbumID = types.StringValue(album.GetForeignAlbumId())
+	c.Title = types.StringValue(album.GetTitle())
+	c.Disambiguation = types.StringValue(album.GetDisambiguation())
+	c.AlbumType = types.StringValue(album.GetAlbumType())
+	c.ArtistID = types.Int64Value(int64(artist.GetId()))
+	c.ArtistName = types.StringValue(artist.GetArtistName())
+	c.ForeignArtistID = types.StringValue(artist.GetForeignArtistId())
+	c.ReleaseDate = types.StringNull()
+
+	if album.ReleaseDate.IsSet() && album.ReleaseDate.Get() != nil {
+		c.ReleaseDate = types.StringValue(album.GetReleaseDate().Format(time.RFC3339))
+	}
+
+	c.SecondaryTypes, localDiag = types.SetValueFrom(ctx, types.StringType, album.GetSecondaryTypes())
+	diags.Append(localDiag...)
+
+	releases := make([]AlbumRelease, len(album.GetReleases()))
+	for i, release := range album.GetReleases() {
+		releases[i].write(ctx, &release, diags)
+	}
+
+	c.Releases, localDiag = types.SetValueFrom(ctx, AlbumRelease{}.getType(), releases)
+	diags.Append(localDiag...)
+}
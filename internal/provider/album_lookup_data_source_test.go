@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlbumLookupDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccLivePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccAlbumLookupDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccAlbumLookupDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_album_lookup.test", "albums.0.foreign_album_id", "f5093c06-23e3-404f-aeaa-40f72885ee3a"),
+					resource.TestCheckResourceAttr("data.lidarr_album_lookup.test", "albums.0.title", "The Dark Side of the Moon"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAlbumLookupDataSourceConfig = `
+data "lidarr_album_lookup" "test" {
+	term = "lidarr:f5093c06-23e3-404f-aeaa-40f72885ee3a"
+}
+`
@@ -0,0 +1,668 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const albumResourceName = "album"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &AlbumResource{}
+	_ resource.ResourceWithImportState = &AlbumResource{}
+)
+
+func NewAlbumResource() resource.Resource {
+	return &AlbumResource{}
+}
+
+// AlbumResource defines the album implementation.
+type AlbumResource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// Album describes the album data model.
+type Album struct {
+	Genres           types.Set    `tfsdk:"genres"`
+	SecondaryTypes   types.Set    `tfsdk:"secondary_types"`
+	Releases         types.Set    `tfsdk:"releases"`
+	Statistics       types.Object `tfsdk:"statistics"`
+	ForeignAlbumID   types.String `tfsdk:"foreign_album_id"`
+	ForeignReleaseID types.String `tfsdk:"foreign_release_id"`
+	Title            types.String `tfsdk:"title"`
+	Disambiguation   types.String `tfsdk:"disambiguation"`
+	AlbumType        types.String `tfsdk:"album_type"`
+	ReleaseDate      types.String `tfsdk:"release_date"`
+	ID               types.Int64  `tfsdk:"id"`
+	ArtistID         types.Int64  `tfsdk:"artist_id"`
+	Monitored        types.Bool   `tfsdk:"monitored"`
+	AnyReleaseOK     types.Bool   `tfsdk:"any_release_ok"`
+}
+
+func (a Album) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"genres":             types.SetType{}.WithElementType(types.StringType),
+			"secondary_types":    types.SetType{}.WithElementType(types.StringType),
+			"releases":           types.SetType{}.WithElementType(AlbumRelease{}.getType()),
+			"statistics":         AlbumStatistics{}.getType(),
+			"foreign_album_id":   types.StringType,
+			"foreign_release_id": types.StringType,
+			"title":              types.StringType,
+			"disambiguation":     types.StringType,
+			"album_type":         types.StringType,
+			"release_date":       types.StringType,
+			"id":                 types.Int64Type,
+			"artist_id":          types.Int64Type,
+			"monitored":          types.BoolType,
+			"any_release_ok":     types.BoolType,
+		})
+}
+
+// AlbumRelease is part of Album.
+type AlbumRelease struct {
+	Country          types.Set    `tfsdk:"country"`
+	Label            types.Set    `tfsdk:"label"`
+	ForeignReleaseID types.String `tfsdk:"foreign_release_id"`
+	Title            types.String `tfsdk:"title"`
+	Status           types.String `tfsdk:"status"`
+	Format           types.String `tfsdk:"format"`
+	Disambiguation   types.String `tfsdk:"disambiguation"`
+	ID               types.Int64  `tfsdk:"id"`
+	TrackCount       types.Int64  `tfsdk:"track_count"`
+	MediumCount      types.Int64  `tfsdk:"medium_count"`
+	Duration         types.Int64  `tfsdk:"duration"`
+	Monitored        types.Bool   `tfsdk:"monitored"`
+}
+
+func (r AlbumRelease) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"country":            types.SetType{}.WithElementType(types.StringType),
+			"label":              types.SetType{}.WithElementType(types.StringType),
+			"foreign_release_id": types.StringType,
+			"title":              types.StringType,
+			"status":             types.StringType,
+			"format":             types.StringType,
+			"disambiguation":     types.StringType,
+			"id":                 types.Int64Type,
+			"track_count":        types.Int64Type,
+			"medium_count":       types.Int64Type,
+			"duration":           types.Int64Type,
+			"monitored":          types.BoolType,
+		})
+}
+
+// AlbumStatistics is part of Album.
+type AlbumStatistics struct {
+	TrackFileCount  types.Int64   `tfsdk:"track_file_count"`
+	TrackCount      types.Int64   `tfsdk:"track_count"`
+	TotalTrackCount types.Int64   `tfsdk:"total_track_count"`
+	SizeOnDisk      types.Int64   `tfsdk:"size_on_disk"`
+	PercentOfTracks types.Float64 `tfsdk:"percent_of_tracks"`
+}
+
+func (s AlbumStatistics) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"track_file_count":  types.Int64Type,
+			"track_count":       types.Int64Type,
+			"total_track_count": types.Int64Type,
+			"size_on_disk":      types.Int64Type,
+			"percent_of_tracks": types.Float64Type,
+		})
+}
+
+// AlbumWithAddOptions describes the album resource data model.
+type AlbumWithAddOptions struct {
+	Album
+	AddOptions types.Object `tfsdk:"add_options"`
+	Adopted    types.Bool   `tfsdk:"adopted"`
+}
+
+// AlbumAddOptions is part of AlbumWithAddOptions.
+type AlbumAddOptions struct {
+	AddType           types.String `tfsdk:"add_type"`
+	SearchForNewAlbum types.Bool   `tfsdk:"search_for_new_album"`
+}
+
+func (r *AlbumResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + albumResourceName
+}
+
+func (r *AlbumResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Albums -->\nAlbum resource.\nIf the album is already known by Lidarr (e.g. added together with its artist) it is adopted, otherwise it is added.\nOn destroy, added albums are deleted while adopted and imported ones are only unmonitored.\nFor more information refer to [Albums](https://wiki.servarr.com/lidarr/library#albums) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Album ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"foreign_album_id": schema.StringAttribute{
+				MarkdownDescription: "Foreign album ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"artist_id": schema.Int64Attribute{
+				MarkdownDescription: "Artist ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Required:            true,
+			},
+			"adopted": schema.BoolAttribute{
+				MarkdownDescription: "Adopted flag, set when the album was already known by Lidarr or imported.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"any_release_ok": schema.BoolAttribute{
+				MarkdownDescription: "Any release OK flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"foreign_release_id": schema.StringAttribute{
+				MarkdownDescription: "Foreign ID of the selected release.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Album title.",
+				Computed:            true,
+			},
+			"disambiguation": schema.StringAttribute{
+				MarkdownDescription: "Disambiguation.",
+				Computed:            true,
+			},
+			"album_type": schema.StringAttribute{
+				MarkdownDescription: "Album type.",
+				Computed:            true,
+			},
+			"release_date": schema.StringAttribute{
+				MarkdownDescription: "Release date.",
+				Computed:            true,
+			},
+			"genres": schema.SetAttribute{
+				MarkdownDescription: "List genres.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"secondary_types": schema.SetAttribute{
+				MarkdownDescription: "Secondary types.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"statistics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Album statistics.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"track_file_count": schema.Int64Attribute{
+						MarkdownDescription: "Track file count.",
+						Computed:            true,
+					},
+					"track_count": schema.Int64Attribute{
+						MarkdownDescription: "Track count.",
+						Computed:            true,
+					},
+					"total_track_count": schema.Int64Attribute{
+						MarkdownDescription: "Total track count.",
+						Computed:            true,
+					},
+					"size_on_disk": schema.Int64Attribute{
+						MarkdownDescription: "Size on disk.",
+						Computed:            true,
+					},
+					"percent_of_tracks": schema.Float64Attribute{
+						MarkdownDescription: "Percent of tracks.",
+						Computed:            true,
+					},
+				},
+			},
+			"releases": schema.SetNestedAttribute{
+				MarkdownDescription: "Album releases.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Release ID.",
+							Computed:            true,
+						},
+						"foreign_release_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign release ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Release status.",
+							Computed:            true,
+						},
+						"format": schema.StringAttribute{
+							MarkdownDescription: "Release format.",
+							Computed:            true,
+						},
+						"disambiguation": schema.StringAttribute{
+							MarkdownDescription: "Disambiguation.",
+							Computed:            true,
+						},
+						"track_count": schema.Int64Attribute{
+							MarkdownDescription: "Track count.",
+							Computed:            true,
+						},
+						"medium_count": schema.Int64Attribute{
+							MarkdownDescription: "Medium count.",
+							Computed:            true,
+						},
+						"duration": schema.Int64Attribute{
+							MarkdownDescription: "Duration.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+						"country": schema.SetAttribute{
+							MarkdownDescription: "Countries.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"label": schema.SetAttribute{
+							MarkdownDescription: "Labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"add_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Add options. Only used when the album is added, changes are ignored afterwards.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"add_type": schema.StringAttribute{
+						MarkdownDescription: "Add type. Valid values are: `automatic`, `manual`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("automatic", "manual"),
+						},
+					},
+					"search_for_new_album": schema.BoolAttribute{
+						MarkdownDescription: "Search for new album flag.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AlbumResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *AlbumResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var album *AlbumWithAddOptions
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &album)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if album is already known
+	albums, _, err := r.client.AlbumAPI.ListAlbum(r.auth).ForeignAlbumId(album.ForeignAlbumID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, albumResourceName, err))
+
+		return
+	}
+
+	var current *lidarr.AlbumResource
+	if len(albums) > 0 {
+		current = &albums[0]
+	} else {
+		current = r.add(ctx, album, &resp.Diagnostics)
+	}
+
+	album.Adopted = types.BoolValue(len(albums) > 0)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if current.GetArtistId() != int32(album.ArtistID.ValueInt64()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("artist_id"),
+			helpers.ResourceError,
+			fmt.Sprintf("Album %s belongs to artist ID %d", album.ForeignAlbumID.ValueString(), current.GetArtistId()),
+		)
+
+		return
+	}
+
+	// Apply album settings
+	album.read(current, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.AlbumAPI.UpdateAlbum(r.auth, strconv.Itoa(int(current.GetId()))).AlbumResource(*current).Execute()
+	if err != nil {
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, albumResourceName, err, validationAttribute(albumResourceName))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created album: "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	album.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &album)...)
+}
+
+func (r *AlbumResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var album *AlbumWithAddOptions
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &album)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get album current value
+	response, httpResp, err := r.client.AlbumAPI.GetAlbumById(r.auth, int32(album.ID.ValueInt64())).Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(albumResourceName, album.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, albumResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+albumResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	album.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &album)...)
+}
+
+func (r *AlbumResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var album *AlbumWithAddOptions
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &album)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get album current value, to keep releases and fields not managed by terraform
+	current, httpResp, err := r.client.AlbumAPI.GetAlbumById(r.auth, int32(album.ID.ValueInt64())).Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseDeletedOutsideError(albumResourceName, album.ID.ValueInt64()))
+
+			return
+		}
+
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, albumResourceName, err))
+
+		return
+	}
+
+	// Update Album
+	album.read(current, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.AlbumAPI.UpdateAlbum(r.auth, strconv.Itoa(int(current.GetId()))).AlbumResource(*current).Execute()
+	if err != nil {
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, albumResourceName, err, validationAttribute(albumResourceName))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+albumResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	album.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &album)...)
+}
+
+func (r *AlbumResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var (
+		ID      int64
+		adopted types.Bool
+	)
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("adopted"), &adopted)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Albums not added by terraform are only unmonitored, states without the flag are handled as adopted
+	if adopted.IsNull() || adopted.ValueBool() {
+		request := lidarr.NewAlbumsMonitoredResource()
+		request.SetAlbumIds([]int32{int32(ID)})
+		request.SetMonitored(false)
+
+		if _, err := r.client.AlbumAPI.PutAlbumMonitor(r.auth).AlbumsMonitoredResource(*request).Execute(); err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, albumResourceName, err))
+
+			return
+		}
+
+		tflog.Trace(ctx, "unmonitored "+albumResourceName+": "+strconv.Itoa(int(ID)))
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Delete album current value
+	_, err := r.client.AlbumAPI.DeleteAlbum(r.auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, albumResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+albumResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *AlbumResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	// Imported albums were not added by terraform
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopted"), true)...)
+	tflog.Trace(ctx, "imported "+albumResourceName+": "+req.ID)
+}
+
+// add adds an album not yet known by Lidarr to the given artist.
+func (r *AlbumResource) add(ctx context.Context, album *AlbumWithAddOptions, diags *diag.Diagnostics) *lidarr.AlbumResource {
+	artist, _, err := r.client.ArtistAPI.GetArtistById(r.auth, int32(album.ArtistID.ValueInt64())).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, artistResourceName, err))
+
+		return nil
+	}
+
+	request := lidarr.NewAlbumResource()
+	request.SetForeignAlbumId(album.ForeignAlbumID.ValueString())
+	request.SetMonitored(album.Monitored.ValueBool())
+	request.SetArtistId(artist.GetId())
+	request.SetArtist(*artist)
+
+	if !album.AddOptions.IsNull() && !album.AddOptions.IsUnknown() {
+		request.SetAddOptions(*album.readAddOptions(ctx, diags))
+	}
+
+	response, _, err := r.client.AlbumAPI.CreateAlbum(r.auth).AlbumResource(*request).Execute()
+	if err != nil {
+		helpers.AddClientError(diags, helpers.Create, albumResourceName, err, validationAttribute(albumResourceName))
+
+		return nil
+	}
+
+	tflog.Trace(ctx, "added "+albumResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	return response
+}
+
+func (a *Album) write(ctx context.Context, album *lidarr.AlbumResource, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	a.Genres, localDiag = types.SetValueFrom(ctx, types.StringType, album.GetGenres())
+	diags.Append(localDiag...)
+	a.SecondaryTypes, localDiag = types.SetValueFrom(ctx, types.StringType, album.GetSecondaryTypes())
+	diags.Append(localDiag...)
+
+	a.ID = types.Int64Value(int64(album.GetId()))
+	a.ArtistID = types.Int64Value(int64(album.GetArtistId()))
+	a.ForeignAlbumID = types.StringValue(album.GetForeignAlbumId())
+	a.Monitored = types.BoolValue(album.GetMonitored())
+	a.AnyReleaseOK = types.BoolValue(album.GetAnyReleaseOk())
+	a.Title = types.StringValue(album.GetTitle())
+	a.Disambiguation = types.StringValue(album.GetDisambiguation())
+	a.AlbumType = types.StringValue(album.GetAlbumType())
+	a.ReleaseDate = types.StringNull()
+	a.ForeignReleaseID = types.StringNull()
+
+	if album.ReleaseDate.IsSet() && album.ReleaseDate.Get() != nil {
+		a.ReleaseDate = types.StringValue(album.GetReleaseDate().Format(time.RFC3339))
+	}
+
+	releases := make([]AlbumRelease, len(album.GetReleases()))
+	for i, release := range album.GetReleases() {
+		releases[i].write(ctx, &release, diags)
+
+		if release.GetMonitored() {
+			a.ForeignReleaseID = types.StringValue(release.GetForeignReleaseId())
+		}
+	}
+
+	a.Releases, localDiag = types.SetValueFrom(ctx, AlbumRelease{}.getType(), releases)
+	diags.Append(localDiag...)
+
+	statistics := album.GetStatistics()
+	assignObjectValue(ctx, diags, &a.Statistics, "statistics", AlbumStatistics{
+		TrackFileCount:  types.Int64Value(int64(statistics.GetTrackFileCount())),
+		TrackCount:      types.Int64Value(int64(statistics.GetTrackCount())),
+		TotalTrackCount: types.Int64Value(int64(statistics.GetTotalTrackCount())),
+		SizeOnDisk:      types.Int64Value(statistics.GetSizeOnDisk()),
+		PercentOfTracks: types.Float64Value(statistics.GetPercentOfTracks()),
+	}, AlbumStatistics{}.getType())
+}
+
+func (r *AlbumRelease) write(ctx context.Context, release *lidarr.AlbumReleaseResource, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	r.Country, localDiag = types.SetValueFrom(ctx, types.StringType, release.GetCountry())
+	diags.Append(localDiag...)
+	r.Label, localDiag = types.SetValueFrom(ctx, types.StringType, release.GetLabel())
+	diags.Append(localDiag...)
+
+	r.ID = types.Int64Value(int64(release.GetId()))
+	r.ForeignReleaseID = types.StringValue(release.GetForeignReleaseId())
+	r.Title = types.StringValue(release.GetTitle())
+	r.Status = types.StringValue(release.GetStatus())
+	r.Format = types.StringValue(release.GetFormat())
+	r.Disambiguation = types.StringValue(release.GetDisambiguation())
+	r.TrackCount = types.Int64Value(int64(release.GetTrackCount()))
+	r.MediumCount = types.Int64Value(int64(release.GetMediumCount()))
+	r.Duration = types.Int64Value(int64(release.GetDuration()))
+	r.Monitored = types.BoolValue(release.GetMonitored())
+}
+
+// read applies the managed values to the given album.
+func (a *Album) read(album *lidarr.AlbumResource, diags *diag.Diagnostics) {
+	album.SetMonitored(a.Monitored.ValueBool())
+
+	if !a.AnyReleaseOK.IsNull() && !a.AnyReleaseOK.IsUnknown() {
+		album.SetAnyReleaseOk(a.AnyReleaseOK.ValueBool())
+	}
+
+	if a.ForeignReleaseID.IsNull() || a.ForeignReleaseID.IsUnknown() {
+		return
+	}
+
+	found := false
+	releases := album.GetReleases()
+
+	for i := range releases {
+		releases[i].SetMonitored(releases[i].GetForeignReleaseId() == a.ForeignReleaseID.ValueString())
+		found = found || releases[i].GetMonitored()
+	}
+
+	if !found {
+		diags.AddAttributeError(
+			path.Root("foreign_release_id"),
+			helpers.ResourceError,
+			fmt.Sprintf("Release %s not found for album %s", a.ForeignReleaseID.ValueString(), album.GetForeignAlbumId()),
+		)
+
+		return
+	}
+
+	album.SetReleases(releases)
+}
+
+func (a *AlbumWithAddOptions) readAddOptions(ctx context.Context, diags *diag.Diagnostics) *lidarr.AddAlbumOptions {
+	addOptions := AlbumAddOptions{}
+	diags.Append(a.AddOptions.As(ctx, &addOptions, basetypes.ObjectAsOptions{})...)
+
+	options := lidarr.NewAddAlbumOptions()
+
+	if !addOptions.AddType.IsNull() && !addOptions.AddType.IsUnknown() {
+		options.SetAddType(lidarr.AlbumAddType(addOptions.AddType.ValueString()))
+	}
+
+	if !addOptions.SearchForNewAlbum.IsNull() && !addOptions.SearchForNewAlbum.IsUnknown() {
+		options.SetSearchForNewAlbum(addOptions.SearchForNewAlbum.ValueBool())
+	}
+
+	return options
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlbumResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccLivePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccAlbumResourceConfig("false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccAlbumResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lidarr_album.test", "id"),
+					resource.TestCheckResourceAttr("lidarr_album.test", "monitored", "true"),
+					resource.TestCheckResourceAttr("lidarr_album.test", "title", "The Dark Side of the Moon"),
+					resource.TestCheckResourceAttr("lidarr_album.test", "album_type", "Album"),
+					resource.TestCheckResourceAttrSet("lidarr_album.test", "foreign_release_id"),
+					resource.TestCheckResourceAttrSet("lidarr_album.test", "adopted"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccAlbumResourceConfig("false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccAlbumResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_album.test", "monitored", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "lidarr_album.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// imported albums are always adopted
+				ImportStateVerifyIgnore: []string{"adopted"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAlbumResourceConfig(monitored string) string {
+	return fmt.Sprintf(`
+		resource "lidarr_artist" "test" {
+			monitored = true
+			artist_name = "Pink Floyd"
+			root_folder_path = "/config"
+			quality_profile_id = 1
+			metadata_profile_id = 1
+			foreign_artist_id = "83d91898-7763-47d7-b03b-b92132375c47"
+			add_options = {
+				monitor = "none"
+				search_for_missing_albums = false
+			}
+		}
+
+		resource "lidarr_album" "test" {
+			artist_id = lidarr_artist.test.id
+			foreign_album_id = "f5093c06-23e3-404f-aeaa-40f72885ee3a"
+			monitored = %s
+			any_release_ok = true
+		}
+	`, monitored)
+}
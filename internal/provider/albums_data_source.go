@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const albumsDataSourceName = "albums"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AlbumsDataSource{}
+
+func NewAlbumsDataSource() datasource.DataSource {
+	return &AlbumsDataSource{}
+}
+
+// AlbumsDataSource defines the albums implementation.
+type AlbumsDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// Albums describes the albums data model.
+type Albums struct {
+	Albums    types.Set    `tfsdk:"albums"`
+	AlbumType types.String `tfsdk:"album_type"`
+	ID        types.String `tfsdk:"id"`
+	ArtistID  types.Int64  `tfsdk:"artist_id"`
+	Monitored types.Bool   `tfsdk:"monitored"`
+}
+
+func (d *AlbumsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + albumsDataSourceName
+}
+
+func (d *AlbumsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Albums -->\nList all available [Albums](../resources/album), optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"artist_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by artist ID.",
+				Optional:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Filter by monitored flag.",
+				Optional:            true,
+			},
+			"album_type": schema.StringAttribute{
+				MarkdownDescription: "Filter by album type (e.g. `Album`, `EP`, `Single`).",
+				Optional:            true,
+			},
+			"albums": schema.SetNestedAttribute{
+				MarkdownDescription: "Album list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Album ID.",
+							Computed:            true,
+						},
+						"foreign_album_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign album ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Album title.",
+							Computed:            true,
+						},
+						"artist_id": schema.Int64Attribute{
+							MarkdownDescription: "Artist ID.",
+							Computed:            true,
+						},
+						"foreign_release_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign ID of the selected release.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+						"any_release_ok": schema.BoolAttribute{
+							MarkdownDescription: "Any release OK flag.",
+							Computed:            true,
+						},
+						"disambiguation": schema.StringAttribute{
+							MarkdownDescription: "Disambiguation.",
+							Computed:            true,
+						},
+						"album_type": schema.StringAttribute{
+							MarkdownDescription: "Album type.",
+							Computed:            true,
+						},
+						"release_date": schema.StringAttribute{
+							MarkdownDescription: "Release date.",
+							Computed:            true,
+						},
+						"genres": schema.SetAttribute{
+							MarkdownDescription: "List genres.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"secondary_types": schema.SetAttribute{
+							MarkdownDescription: "Secondary types.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"statistics": schema.SingleNestedAttribute{
+							MarkdownDescription: "Album statistics.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"track_file_count": schema.Int64Attribute{
+									MarkdownDescription: "Track file count.",
+									Computed:            true,
+								},
+								"track_count": schema.Int64Attribute{
+									MarkdownDescription: "Track count.",
+									Computed:            true,
+								},
+								"total_track_count": schema.Int64Attribute{
+									MarkdownDescription: "Total track count.",
+									Computed:            true,
+								},
+								"size_on_disk": schema.Int64Attribute{
+									MarkdownDescription: "Size on disk.",
+									Computed:            true,
+								},
+								"percent_of_tracks": schema.Float64Attribute{
+									MarkdownDescription: "Percent of tracks.",
+									Computed:            true,
+								},
+							},
+						},
+						"releases": schema.SetNestedAttribute{
+							MarkdownDescription: "Album releases.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.Int64Attribute{
+										MarkdownDescription: "Release ID.",
+										Computed:            true,
+									},
+									"foreign_release_id": schema.StringAttribute{
+										MarkdownDescription: "Foreign release ID.",
+										Computed:            true,
+									},
+									"title": schema.StringAttribute{
+										MarkdownDescription: "Release title.",
+										Computed:            true,
+									},
+									"status": schema.StringAttribute{
+										MarkdownDescription: "Release status.",
+										Computed:            true,
+									},
+									"format": schema.StringAttribute{
+										MarkdownDescription: "Release format.",
+										Computed:            true,
+									},
+									"disambiguation": schema.StringAttribute{
+										MarkdownDescription: "Disambiguation.",
+										Computed:            true,
+									},
+									"track_count": schema.Int64Attribute{
+										MarkdownDescription: "Track count.",
+										Computed:            true,
+									},
+									"medium_count": schema.Int64Attribute{
+										MarkdownDescription: "Medium count.",
+										Computed:            true,
+									},
+									"duration": schema.Int64Attribute{
+										MarkdownDescription: "Duration.",
+										Computed:            true,
+									},
+									"monitored": schema.BoolAttribute{
+										MarkdownDescription: "Monitored flag.",
+										Computed:            true,
+									},
+									"country": schema.SetAttribute{
+										MarkdownDescription: "Countries.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+									"label": schema.SetAttribute{
+										MarkdownDescription: "Labels.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AlbumsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *AlbumsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Albums
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get albums current value
+	request := d.client.AlbumAPI.ListAlbum(d.auth)
+	if !data.ArtistID.IsNull() {
+		request = request.ArtistId(int32(data.ArtistID.ValueInt64()))
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, albumsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+albumsDataSourceName)
+	// Map response body to resource schema attribute
+	albums := make([]Album, 0, len(response))
+
+	for _, a := range response {
+		if (!data.Monitored.IsNull() && a.GetMonitored() != data.Monitored.ValueBool()) ||
+			(!data.AlbumType.IsNull() && a.GetAlbumType() != data.AlbumType.ValueString()) {
+			continue
+		}
+
+		album := Album{}
+		album.write(ctx, &a, &resp.Diagnostics)
+		albums = append(albums, album)
+	}
+
+	albumList, diags := types.SetValueFrom(ctx, Album{}.getType(), albums)
+	resp.Diagnostics.Append(diags...)
+
+	data.Albums = albumList
+	data.ID = types.StringValue(strconv.Itoa(len(albums)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlbumsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccLivePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccAlbumsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccAlbumsDataSourceArtistConfig + testAccAlbumsDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.lidarr_albums.test", "albums.*", map[string]string{"title": "OK Computer", "album_type": "Album"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccAlbumsDataSourceConfig = `
+data "lidarr_albums" "test" {
+}
+`
+
+const testAccAlbumsDataSourceArtistConfig = `
+resource "lidarr_artist" "test" {
+	monitored = false
+	artist_name = "Radiohead"
+	root_folder_path = "/config"
+	quality_profile_id = 1
+	metadata_profile_id = 1
+	foreign_artist_id = "a74b1b7f-71a5-4011-9441-d0b5e4122711"
+	add_options = {
+		monitor = "none"
+		search_for_missing_albums = false
+	}
+}
+`
+
+const testAccAlbumsDataSourceFilterConfig = `
+data "lidarr_albums" "test" {
+	artist_id = lidarr_artist.test.id
+	album_type = "Album"
+	monitored = false
+}
+`
@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	artistAlbumMonitoringResourceName = "artist_album_monitoring"
+	artistAlbumMonitoringDateLayout   = "2006-01-02"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ArtistAlbumMonitoringResource{}
+	_ resource.ResourceWithImportState = &ArtistAlbumMonitoringResource{}
+	_ resource.ResourceWithModifyPlan  = &ArtistAlbumMonitoringResource{}
+)
+
+func NewArtistAlbumMonitoringResource() resource.Resource {
+	return &ArtistAlbumMonitoringResource{}
+}
+
+// ArtistAlbumMonitoringResource defines the artist album monitoring implementation.
+type ArtistAlbumMonitoringResource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// ArtistAlbumMonitoring describes the artist album monitoring data model.
+type ArtistAlbumMonitoring struct {
+	AlbumTypes               types.Set    `tfsdk:"album_types"`
+	IncludeForeignAlbumIDs   types.Set    `tfsdk:"include_foreign_album_ids"`
+	ExcludeForeignAlbumIDs   types.Set    `tfsdk:"exclude_foreign_album_ids"`
+	MonitoredForeignAlbumIDs types.Set    `tfsdk:"monitored_foreign_album_ids"`
+	ReleaseDateFrom          types.String `tfsdk:"release_date_from"`
+	ReleaseDateTo            types.String `tfsdk:"release_date_to"`
+	ID                       types.Int64  `tfsdk:"id"`
+	ArtistID                 types.Int64  `tfsdk:"artist_id"`
+}
+
+func (r *ArtistAlbumMonitoringResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + artistAlbumMonitoringResourceName
+}
+
+func (r *ArtistAlbumMonitoringResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Albums -->\nArtist album monitoring resource.\nIt converges the monitored flag of all the albums of an artist in bulk.\nAn album is monitored if it is included or if it matches all the configured rules (`album_types`, `release_date_from`, `release_date_to`), unless it is excluded.\nIf no rule is configured, only the included albums are monitored.\nDestroying the resource does not change the albums monitored flag.\nFor more information refer to [Albums](https://wiki.servarr.com/lidarr/library#albums) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Artist album monitoring ID. It is the same as the artist ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"artist_id": schema.Int64Attribute{
+				MarkdownDescription: "Artist ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"album_types": schema.SetAttribute{
+				MarkdownDescription: "Album types to be monitored (e.g. `Album`, `EP`, `Single`).",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"release_date_from": schema.StringAttribute{
+				MarkdownDescription: "Monitor albums released on or after this date (`YYYY-MM-DD`).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be in YYYY-MM-DD format"),
+				},
+			},
+			"release_date_to": schema.StringAttribute{
+				MarkdownDescription: "Monitor albums released on or before this date (`YYYY-MM-DD`).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be in YYYY-MM-DD format"),
+				},
+			},
+			"include_foreign_album_ids": schema.SetAttribute{
+				MarkdownDescription: "Foreign album IDs always monitored.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"exclude_foreign_album_ids": schema.SetAttribute{
+				MarkdownDescription: "Foreign album IDs never monitored. It takes precedence over all other rules.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"monitored_foreign_album_ids": schema.SetAttribute{
+				MarkdownDescription: "Foreign album IDs of the monitored albums.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ArtistAlbumMonitoringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *ArtistAlbumMonitoringResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or if provider is not configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var monitoring *ArtistAlbumMonitoring
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &monitoring)...)
+
+	if resp.Diagnostics.HasError() || !monitoring.isKnown() {
+		return
+	}
+
+	// Compute the expected monitored albums to detect drifts and new albums
+	albums, _, err := r.client.AlbumAPI.ListAlbum(r.auth).ArtistId(int32(monitoring.ArtistID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, albumsDataSourceName, err))
+
+		return
+	}
+
+	monitored, _ := monitoring.split(ctx, albums, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("monitored_foreign_album_ids"), monitored)...)
+}
+
+func (r *ArtistAlbumMonitoringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var monitoring *ArtistAlbumMonitoring
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &monitoring)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new ArtistAlbumMonitoring
+	r.apply(ctx, monitoring, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+artistAlbumMonitoringResourceName+": "+strconv.Itoa(int(monitoring.ArtistID.ValueInt64())))
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &monitoring)...)
+}
+
+func (r *ArtistAlbumMonitoringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var monitoring *ArtistAlbumMonitoring
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &monitoring)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get artist albums current value
+	response, httpResp, err := r.client.ArtistAPI.GetArtistById(r.auth, int32(monitoring.ID.ValueInt64())).Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(artistAlbumMonitoringResourceName, monitoring.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, artistAlbumMonitoringResourceName, err))
+
+		return
+	}
+
+	albums, _, err := r.client.AlbumAPI.ListAlbum(r.auth).ArtistId(response.GetId()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, artistAlbumMonitoringResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+artistAlbumMonitoringResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	monitoring.write(ctx, response.GetId(), albums, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &monitoring)...)
+}
+
+func (r *ArtistAlbumMonitoringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var monitoring *ArtistAlbumMonitoring
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &monitoring)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update ArtistAlbumMonitoring
+	r.apply(ctx, monitoring, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+artistAlbumMonitoringResourceName+": "+strconv.Itoa(int(monitoring.ArtistID.ValueInt64())))
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &monitoring)...)
+}
+
+func (r *ArtistAlbumMonitoringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Albums monitoring is left as is
+	tflog.Trace(ctx, "deleted "+artistAlbumMonitoringResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ArtistAlbumMonitoringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+artistAlbumMonitoringResourceName+": "+req.ID)
+}
+
+// apply converges the monitored flag of all the artist albums.
+func (r *ArtistAlbumMonitoringResource) apply(ctx context.Context, monitoring *ArtistAlbumMonitoring, diags *diag.Diagnostics) {
+	artistID := int32(monitoring.ArtistID.ValueInt64())
+
+	albums, _, err := r.client.AlbumAPI.ListAlbum(r.auth).ArtistId(artistID).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, albumsDataSourceName, err))
+
+		return
+	}
+
+	_, albumIDs := monitoring.split(ctx, albums, diags)
+
+	if diags.HasError() {
+		return
+	}
+
+	for _, monitored := range []bool{true, false} {
+		if len(albumIDs[monitored]) == 0 {
+			continue
+		}
+
+		request := lidarr.NewAlbumsMonitoredResource()
+		request.SetAlbumIds(albumIDs[monitored])
+		request.SetMonitored(monitored)
+
+		if _, err := r.client.AlbumAPI.PutAlbumMonitor(r.auth).AlbumsMonitoredResource(*request).Execute(); err != nil {
+			helpers.AddClientError(diags, helpers.Update, artistAlbumMonitoringResourceName, err, validationAttribute(artistAlbumMonitoringResourceName))
+
+			return
+		}
+	}
+
+	// Read back the albums to store the actual result
+	albums, _, err = r.client.AlbumAPI.ListAlbum(r.auth).ArtistId(artistID).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, artistAlbumMonitoringResourceName, err))
+
+		return
+	}
+
+	monitoring.write(ctx, artistID, albums, diags)
+}
+
+func (m *ArtistAlbumMonitoring) write(ctx context.Context, artistID int32, albums []lidarr.AlbumResource, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	monitored := make([]string, 0, len(albums))
+
+	for _, album := range albums {
+		if album.GetMonitored() {
+			monitored = append(monitored, album.GetForeignAlbumId())
+		}
+	}
+
+	m.ID = types.Int64Value(int64(artistID))
+	m.ArtistID = types.Int64Value(int64(artistID))
+	m.MonitoredForeignAlbumIDs, localDiag = types.SetValueFrom(ctx, types.StringType, monitored)
+	diags.Append(localDiag...)
+}
+
+// isKnown checks if all the rules are known.
+func (m *ArtistAlbumMonitoring) isKnown() bool {
+	return !m.ArtistID.IsUnknown() &&
+		!m.AlbumTypes.IsUnknown() &&
+		!m.IncludeForeignAlbumIDs.IsUnknown() &&
+		!m.ExcludeForeignAlbumIDs.IsUnknown() &&
+		!m.ReleaseDateFrom.IsUnknown() &&
+		!m.ReleaseDateTo.IsUnknown()
+}
+
+// split returns the expected monitored foreign album IDs and the album IDs grouped by expected monitored flag.
+func (m *ArtistAlbumMonitoring) split(ctx context.Context, albums []lidarr.AlbumResource, diags *diag.Diagnostics) (types.Set, map[bool][]int32) {
+	var albumTypes, include, exclude []string
+
+	diags.Append(m.AlbumTypes.ElementsAs(ctx, &albumTypes, true)...)
+	diags.Append(m.IncludeForeignAlbumIDs.ElementsAs(ctx, &include, true)...)
+	diags.Append(m.ExcludeForeignAlbumIDs.ElementsAs(ctx, &exclude, true)...)
+
+	from := m.parseDate(m.ReleaseDateFrom, "release_date_from", diags)
+	to := m.parseDate(m.ReleaseDateTo, "release_date_to", diags)
+	hasRules := !m.AlbumTypes.IsNull() || from != nil || to != nil
+
+	monitored := make([]string, 0, len(albums))
+	albumIDs := map[bool][]int32{true: {}, false: {}}
+
+	for _, album := range albums {
+		expected := hasRules
+		releaseDate := album.GetReleaseDate()
+
+		switch {
+		case slices.Contains(exclude, album.GetForeignAlbumId()):
+			expected = false
+		case slices.Contains(include, album.GetForeignAlbumId()):
+			expected = true
+		case !m.AlbumTypes.IsNull() && !slices.Contains(albumTypes, album.GetAlbumType()):
+			expected = false
+		case from != nil && (releaseDate.IsZero() || releaseDate.Before(*from)):
+			expected = false
+		case to != nil && (releaseDate.IsZero() || releaseDate.After(*to)):
+			expected = false
+		}
+
+		if expected {
+			monitored = append(monitored, album.GetForeignAlbumId())
+		}
+
+		albumIDs[expected] = append(albumIDs[expected], album.GetId())
+	}
+
+	output, localDiag := types.SetValueFrom(ctx, types.StringType, monitored)
+	diags.Append(localDiag...)
+
+	return output, albumIDs
+}
+
+// parseDate parses an optional date attribute.
+func (m *ArtistAlbumMonitoring) parseDate(value types.String, name string, diags *diag.Diagnostics) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	date, err := time.Parse(artistAlbumMonitoringDateLayout, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(name), helpers.ResourceError, err.Error())
+
+		return nil
+	}
+
+	// Include the whole day in the upper bound.
+	if name == "release_date_to" {
+		date = date.Add(24*time.Hour - time.Nanosecond)
+	}
+
+	return &date
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArtistAlbumMonitoringResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccLivePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccArtistAlbumMonitoringResourceConfig("Album") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccArtistAlbumMonitoringResourceConfig("Album"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("lidarr_artist_album_monitoring.test", "id", "lidarr_artist.test", "id"),
+					resource.TestCheckTypeSetElemAttr("lidarr_artist_album_monitoring.test", "monitored_foreign_album_ids.*", "f5093c06-23e3-404f-aeaa-40f72885ee3a"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccArtistAlbumMonitoringResourceConfig("Album") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccArtistAlbumMonitoringResourceConfig("Single"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_artist_album_monitoring.test", "album_types.0", "Single"),
+					resource.TestCheckTypeSetElemAttr("lidarr_artist_album_monitoring.test", "monitored_foreign_album_ids.*", "f5093c06-23e3-404f-aeaa-40f72885ee3a"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "lidarr_artist_album_monitoring.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"album_types", "release_date_from", "include_foreign_album_ids"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccArtistAlbumMonitoringResourceConfig(albumType string) string {
+	return fmt.Sprintf(`
+		resource "lidarr_artist" "test" {
+			monitored = true
+			artist_name = "Pink Floyd"
+			root_folder_path = "/config"
+			quality_profile_id = 1
+			metadata_profile_id = 1
+			foreign_artist_id = "83d91898-7763-47d7-b03b-b92132375c47"
+			add_options = {
+				monitor = "none"
+				search_for_missing_albums = false
+			}
+		}
+
+		resource "lidarr_artist_album_monitoring" "test" {
+			artist_id = lidarr_artist.test.id
+			album_types = ["%s"]
+			release_date_from = "1970-01-01"
+			include_foreign_album_ids = ["f5093c06-23e3-404f-aeaa-40f72885ee3a"]
+		}
+	`, albumType)
+}
@@ -31,6 +31,8 @@ func TestAccArtistDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.lidarr_artist.test", "id"),
 					resource.TestCheckResourceAttr("data.lidarr_artist.test", "artist_name", "Ludwig van Beethoven"),
+					resource.TestCheckResourceAttr("data.lidarr_artist.test", "root_folder_path", "/config"),
+					resource.TestCheckResourceAttrSet("data.lidarr_artist.test", "sort_name"),
 				),
 			},
 		},
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const artistLookupDataSourceName = "artist_lookup"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ArtistLookupDataSource{}
+
+func NewArtistLookupDataSource() datasource.DataSource {
+	return &ArtistLookupDataSource{}
+}
+
+// ArtistLookupDataSource defines the artist lookup implementation.
+type ArtistLookupDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// ArtistLookup describes the artist lookup data model.
+type ArtistLookup struct {
+	Artists types.List   `tfsdk:"artists"`
+	Term    types.String `tfsdk:"term"`
+	ID      types.String `tfsdk:"id"`
+}
+
+// ArtistLookupCandidate describes the artist lookup candidate data model.
+type ArtistLookupCandidate struct {
+	Genres          types.Set    `tfsdk:"genres"`
+	ForeignArtistID types.String `tfsdk:"foreign_artist_id"`
+	ArtistName      types.String `tfsdk:"artist_name"`
+	Disambiguation  types.String `tfsdk:"disambiguation"`
+	ArtistType      types.String `tfsdk:"artist_type"`
+	Overview        types.String `tfsdk:"overview"`
+	Status          types.String `tfsdk:"status"`
+	ID              types.Int64  `tfsdk:"id"`
+}
+
+func (c ArtistLookupCandidate) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"genres":            types.SetType{}.WithElementType(types.StringType),
+			"foreign_artist_id": types.StringType,
+			"artist_name":       types.StringType,
+			"disambiguation":    types.StringType,
+			"artist_type":       types.StringType,
+			"overview":          types.StringType,
+			"status":            types.StringType,
+			"id":                types.Int64Type,
+		})
+}
+
+func (d *ArtistLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + artistLookupDataSourceName
+}
+
+func (d *ArtistLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Artists -->\nSearch [Artists](../resources/artist) candidates through the metadata provider, ordered by relevance.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"term": schema.StringAttribute{
+				MarkdownDescription: "Search term. It can be an artist name or a `lidarr:`/`mbid:` prefixed foreign artist ID.",
+				Required:            true,
+			},
+			"artists": schema.ListNestedAttribute{
+				MarkdownDescription: "Artist candidates.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Artist ID. It is `0` if the artist is not in the library.",
+							Computed:            true,
+						},
+						"foreign_artist_id": schema.StringAttribute{
+							MarkdownDescription: "Foreign artist ID.",
+							Computed:            true,
+						},
+						"artist_name": schema.StringAttribute{
+							MarkdownDescription: "Artist name.",
+							Computed:            true,
+						},
+						"disambiguation": schema.StringAttribute{
+							MarkdownDescription: "Disambiguation.",
+							Computed:            true,
+						},
+						"artist_type": schema.StringAttribute{
+							MarkdownDescription: "Artist type.",
+							Computed:            true,
+						},
+						"overview": schema.StringAttribute{
+							MarkdownDescription: "Overview.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Artist status.",
+							Computed:            true,
+						},
+						"genres": schema.SetAttribute{
+							MarkdownDescription: "List genres.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ArtistLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *ArtistLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ArtistLookup
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get artist lookup current value
+	response, _, err := d.client.ArtistLookupAPI.ListArtistLookup(d.auth).Term(data.Term.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, artistLookupDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+artistLookupDataSourceName)
+	// Map response body to resource schema attribute
+	artists := make([]ArtistLookupCandidate, len(response))
+	for i, a := range response {
+		artists[i].write(ctx, &a, &resp.Diagnostics)
+	}
+
+	artistList, diags := types.ListValueFrom(ctx, ArtistLookupCandidate{}.getType(), artists)
+	resp.Diagnostics.Append(diags...)
+
+	data.Artists = artistList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (c *ArtistLookupCandidate) write(ctx context.Context, artist *lidarr.ArtistResource, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	c.ID = types.Int64Value(int64(artist.GetId()))
+	c.ForeignArtistID = types.StringValue(artist.GetForeignArtistId())
+	c.ArtistName = types.StringValue(artist.GetArtistName())
+	c.Disambiguation = types.StringValue(artist.GetDisambiguation())
+	c.ArtistType = types.StringValue(artist.GetArtistType())
+	c.Overview = types.StringValue(artist.GetOverview())
+	c.Status = types.StringValue(string(artist.GetStatus()))
+	c.Genres, localDiag = types.SetValueFrom(ctx, types.StringType, artist.GetGenres())
+	diags.Append(localDiag...)
+}
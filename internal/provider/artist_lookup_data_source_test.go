@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArtistLookupDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccLivePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccArtistLookupDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccArtistLookupDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_artist_lookup.test", "artists.0.foreign_artist_id", "a74b1b7f-71a5-4011-9441-d0b5e4122711"),
+					resource.TestCheckResourceAttr("data.lidarr_artist_lookup.test", "artists.0.artist_name", "Radiohead"),
+				),
+			},
+		},
+	})
+}
+
+const testAccArtistLookupDataSourceConfig = `
+data "lidarr_artist_lookup" "test" {
+	term = "lidarr:a74b1b7f-71a5-4011-9441-d0b5e4122711"
+}
+`
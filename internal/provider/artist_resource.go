@@ -2,11 +2,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,7 +18,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -40,30 +47,81 @@ type ArtistResource struct {
 type Artist struct {
 	Genres            types.Set    `tfsdk:"genres"`
 	Tags              types.Set    `tfsdk:"tags"`
+	Links             types.Set    `tfsdk:"links"`
+	Ratings           types.Object `tfsdk:"ratings"`
 	ArtistName        types.String `tfsdk:"artist_name"`
 	ForeignArtistID   types.String `tfsdk:"foreign_artist_id"`
 	Status            types.String `tfsdk:"status"`
 	Path              types.String `tfsdk:"path"`
+	RootFolderPath    types.String `tfsdk:"root_folder_path"`
+	FolderName        types.String `tfsdk:"folder_name"`
 	Overview          types.String `tfsdk:"overview"`
+	MonitorNewItems   types.String `tfsdk:"monitor_new_items"`
+	ArtistType        types.String `tfsdk:"artist_type"`
+	Disambiguation    types.String `tfsdk:"disambiguation"`
+	SortName          types.String `tfsdk:"sort_name"`
+	Added             types.String `tfsdk:"added"`
 	ID                types.Int64  `tfsdk:"id"`
 	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
 	MetadataProfileID types.Int64  `tfsdk:"metadata_profile_id"`
+	TadbID            types.Int64  `tfsdk:"tadb_id"`
+	DiscogsID         types.Int64  `tfsdk:"discogs_id"`
 	Monitored         types.Bool   `tfsdk:"monitored"`
+	Ended             types.Bool   `tfsdk:"ended"`
 
 	// TODO: future Implementation
-	// Links          types.Set    `tfsdk:"links"`
-	// SortName       types.String `tfsdk:"sortName"`
-	// Ended          types.Bool   `tfsdk:"ended"`
-	// ArtistType     types.String `tfsdk:"artist_type"`
-	// Disambiguation types.String `tfsdk:"disambiguation"`
-	// RootFolderPath types.String `tfsdk:"root_folder_path"`
-	// FolderName     types.String `tfsdk:"folderName"`
 	// CleanName      types.String `tfsdk:"cleanName"`
 	// Certification  types.String `tfsdk:"certification"`
-	// Added          types.String `tfsdk:"added"`
-	// Ratings        types.Object `tfsdk:"ratings"`
-	// TadbId         types.Int64  `tfsdk:"tadb_id"`
-	// DiscogsId      types.Int64  `tfsdk:"discogs_id"`
+}
+
+// ArtistLink is part of Artist.
+type ArtistLink struct {
+	Name types.String `tfsdk:"name"`
+	URL  types.String `tfsdk:"url"`
+}
+
+func (l ArtistLink) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name": types.StringType,
+			"url":  types.StringType,
+		})
+}
+
+// ArtistRatings is part of Artist.
+type ArtistRatings struct {
+	Votes types.Int64   `tfsdk:"votes"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+func (r ArtistRatings) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"votes": types.Int64Type,
+			"value": types.Float64Type,
+		})
+}
+
+// ArtistWithAddOptions describes the artist resource data model.
+type ArtistWithAddOptions struct {
+	Artist
+	AddOptions types.Object `tfsdk:"add_options"`
+}
+
+// ArtistAddOptions is part of ArtistWithAddOptions.
+type ArtistAddOptions struct {
+	AlbumsToMonitor        types.Set    `tfsdk:"albums_to_monitor"`
+	Monitor                types.String `tfsdk:"monitor"`
+	SearchForMissingAlbums types.Bool   `tfsdk:"search_for_missing_albums"`
+}
+
+func (o ArtistAddOptions) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"albums_to_monitor":         types.SetType{}.WithElementType(types.StringType),
+			"monitor":                   types.StringType,
+			"search_for_missing_albums": types.BoolType,
+		})
 }
 
 func (a Artist) getType() attr.Type {
@@ -78,8 +136,20 @@ func (a Artist) getType() attr.Type {
 			"status":              types.StringType,
 			"path":                types.StringType,
 			"overview":            types.StringType,
+			"monitor_new_items":   types.StringType,
+			"root_folder_path":    types.StringType,
+			"folder_name":         types.StringType,
+			"artist_type":         types.StringType,
+			"disambiguation":      types.StringType,
+			"sort_name":           types.StringType,
+			"added":               types.StringType,
+			"tadb_id":             types.Int64Type,
+			"discogs_id":          types.Int64Type,
+			"ended":               types.BoolType,
 			"genres":              types.SetType{}.WithElementType(types.StringType),
 			"tags":                types.SetType{}.WithElementType(types.Int64Type),
+			"links":               types.SetType{}.WithElementType(ArtistLink{}.getType()),
+			"ratings":             ArtistRatings{}.getType(),
 		})
 }
 
@@ -115,8 +185,30 @@ func (r *ArtistResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:            true,
 			},
 			"path": schema.StringAttribute{
-				MarkdownDescription: "Full artist path.",
-				Required:            true,
+				MarkdownDescription: "Full artist path. If not set, it is derived from `root_folder_path` and Lidarr artist folder naming.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("root_folder_path")),
+				},
+				PlanModifiers: []planmodifier.String{
+					artistFolderPlanModifier{sibling: "root_folder_path"},
+				},
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					artistFolderPlanModifier{sibling: "path"},
+				},
+			},
+			"folder_name": schema.StringAttribute{
+				MarkdownDescription: "Artist folder name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					artistFolderPlanModifier{sibling: "path"},
+				},
 			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "Artist status.",
@@ -130,6 +222,17 @@ func (r *ArtistResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				MarkdownDescription: "Foreign artist ID.",
 				Required:            true,
 			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new items.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "none", "new"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
@@ -141,6 +244,86 @@ func (r *ArtistResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"artist_type": schema.StringAttribute{
+				MarkdownDescription: "Artist type.",
+				Computed:            true,
+			},
+			"disambiguation": schema.StringAttribute{
+				MarkdownDescription: "Disambiguation.",
+				Computed:            true,
+			},
+			"sort_name": schema.StringAttribute{
+				MarkdownDescription: "Sort name.",
+				Computed:            true,
+			},
+			"added": schema.StringAttribute{
+				MarkdownDescription: "Added date.",
+				Computed:            true,
+			},
+			"ended": schema.BoolAttribute{
+				MarkdownDescription: "Ended flag.",
+				Computed:            true,
+			},
+			"tadb_id": schema.Int64Attribute{
+				MarkdownDescription: "TADB ID.",
+				Computed:            true,
+			},
+			"discogs_id": schema.Int64Attribute{
+				MarkdownDescription: "Discogs ID.",
+				Computed:            true,
+			},
+			"links": schema.SetNestedAttribute{
+				MarkdownDescription: "External links.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Link name.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Link URL.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ratings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Ratings.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"votes": schema.Int64Attribute{
+						MarkdownDescription: "Votes.",
+						Computed:            true,
+					},
+					"value": schema.Float64Attribute{
+						MarkdownDescription: "Value.",
+						Computed:            true,
+					},
+				},
+			},
+			"add_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Add options. Only used on creation, changes are ignored afterwards.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"monitor": schema.StringAttribute{
+						MarkdownDescription: "Monitor albums type. Valid values are: `all`, `future`, `missing`, `existing`, `first`, `latest`, `none`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("all", "future", "missing", "existing", "first", "latest", "none"),
+						},
+					},
+					"search_for_missing_albums": schema.BoolAttribute{
+						MarkdownDescription: "Search for missing albums flag.",
+						Optional:            true,
+					},
+					"albums_to_monitor": schema.SetAttribute{
+						MarkdownDescription: "List of foreign album IDs to monitor.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
 		},
 	}
 }
@@ -154,7 +337,7 @@ func (r *ArtistResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 func (r *ArtistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var artist *Artist
+	var artist *ArtistWithAddOptions
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &artist)...)
 
@@ -164,14 +347,13 @@ func (r *ArtistResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Create new Artist
 	request := artist.read(ctx, &resp.Diagnostics)
-	// TODO: can parametrize AddArtistOptions
-	options := lidarr.NewAddArtistOptions()
-	options.SetMonitor(lidarr.MONITORTYPES_ALL)
-	options.SetSearchForMissingAlbums(true)
+	if !artist.AddOptions.IsNull() && !artist.AddOptions.IsUnknown() {
+		request.SetAddOptions(*artist.readAddOptions(ctx, &resp.Diagnostics))
+	}
 
 	response, _, err := r.client.ArtistAPI.CreateArtist(r.auth).ArtistResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, artistResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, artistResourceName, err, validationAttribute(artistResourceName))
 
 		return
 	}
@@ -184,7 +366,7 @@ func (r *ArtistResource) Create(ctx context.Context, req resource.CreateRequest,
 
 func (r *ArtistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var artist *Artist
+	var artist *ArtistWithAddOptions
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &artist)...)
 
@@ -193,8 +375,15 @@ func (r *ArtistResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Get artist current value
-	response, _, err := r.client.ArtistAPI.GetArtistById(r.auth, int32(artist.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ArtistAPI.GetArtistById(r.auth, int32(artist.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(artistResourceName, artist.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, artistResourceName, err))
 
 		return
@@ -208,7 +397,7 @@ func (r *ArtistResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 func (r *ArtistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var artist *Artist
+	var artist *ArtistWithAddOptions
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &artist)...)
 
@@ -221,7 +410,7 @@ func (r *ArtistResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	response, _, err := r.client.ArtistAPI.UpdateArtist(r.auth, fmt.Sprint(request.GetId())).ArtistResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, artistResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, artistResourceName, err, validationAttribute(artistResourceName))
 
 		return
 	}
@@ -254,7 +443,9 @@ func (r *ArtistResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *ArtistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return artistIDByName(r.auth, r.client, name)
+	})
 	tflog.Trace(ctx, "imported "+artistResourceName+": "+req.ID)
 }
 
@@ -276,18 +467,129 @@ func (a *Artist) write(ctx context.Context, artist *lidarr.ArtistResource, diags
 	// Read only values
 	a.Status = types.StringValue(string(artist.GetStatus()))
 	a.Overview = types.StringValue(artist.GetOverview())
+	a.MonitorNewItems = types.StringValue(string(artist.GetMonitorNewItems()))
+	a.RootFolderPath = types.StringValue(artist.GetRootFolderPath())
+	a.FolderName = types.StringValue(artist.GetFolder())
+	a.ArtistType = types.StringValue(artist.GetArtistType())
+	a.Disambiguation = types.StringValue(artist.GetDisambiguation())
+	a.SortName = types.StringValue(artist.GetSortName())
+	a.TadbID = types.Int64Value(int64(artist.GetTadbId()))
+	a.DiscogsID = types.Int64Value(int64(artist.GetDiscogsId()))
+	a.Ended = types.BoolValue(artist.GetEnded())
+
+	// Lidarr omits the added date for artists not yet stored
+	a.Added = types.StringNull()
+	if added := artist.GetAdded(); !added.IsZero() {
+		a.Added = types.StringValue(added.Format(time.RFC3339))
+	}
+
+	links := make([]ArtistLink, len(artist.GetLinks()))
+	for i, l := range artist.GetLinks() {
+		links[i].Name = types.StringValue(l.GetName())
+		links[i].URL = types.StringValue(l.GetUrl())
+	}
+
+	a.Links, localDiag = types.SetValueFrom(ctx, ArtistLink{}.getType(), links)
+	diags.Append(localDiag...)
+
+	ratings := artist.GetRatings()
+	assignObjectValue(ctx, diags, &a.Ratings, "ratings", ArtistRatings{
+		Votes: types.Int64Value(int64(ratings.GetVotes())),
+		Value: types.Float64Value(ratings.GetValue()),
+	}, ArtistRatings{}.getType())
 }
 
 func (a *Artist) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.ArtistResource {
 	artist := lidarr.NewArtistResource()
 	artist.SetMonitored(a.Monitored.ValueBool())
 	artist.SetArtistName(a.ArtistName.ValueString())
-	artist.SetPath(a.Path.ValueString())
 	artist.SetQualityProfileId(int32(a.QualityProfileID.ValueInt64()))
 	artist.SetMetadataProfileId(int32(a.MetadataProfileID.ValueInt64()))
 	artist.SetForeignArtistId(a.ForeignArtistID.ValueString())
 	artist.SetId(int32(a.ID.ValueInt64()))
 	diags.Append(a.Tags.ElementsAs(ctx, &artist.Tags, true)...)
 
+	if !a.MonitorNewItems.IsNull() && !a.MonitorNewItems.IsUnknown() {
+		artist.SetMonitorNewItems(lidarr.NewItemMonitorTypes(a.MonitorNewItems.ValueString()))
+	}
+
+	if !a.RootFolderPath.IsNull() && !a.RootFolderPath.IsUnknown() {
+		artist.SetRootFolderPath(a.RootFolderPath.ValueString())
+	}
+
+	// Path is derived by Lidarr on creation, and from root folder and folder name on update.
+	switch {
+	case !a.Path.IsNull() && !a.Path.IsUnknown():
+		artist.SetPath(a.Path.ValueString())
+	case artist.HasRootFolderPath() && !a.FolderName.IsNull() && !a.FolderName.IsUnknown():
+		artist.SetPath(strings.TrimRight(artist.GetRootFolderPath(), "/\\") + "/" + a.FolderName.ValueString())
+	}
+
 	return artist
 }
+
+// artistFolderPlanModifier keeps the state value unless the sibling folder attribute is changed in config.
+type artistFolderPlanModifier struct {
+	sibling string
+}
+
+func (m artistFolderPlanModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless " + m.sibling + " changes."
+}
+
+func (m artistFolderPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m artistFolderPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on creation, on destroy or if the value is configured.
+	if req.StateValue.IsNull() || req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var siblingConfig, siblingState types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(m.sibling), &siblingConfig)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(m.sibling), &siblingState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Leave the value unknown if the sibling folder attribute is changing.
+	if siblingConfig.IsUnknown() || (!siblingConfig.IsNull() && !siblingConfig.Equal(siblingState)) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+func (a *ArtistWithAddOptions) readAddOptions(ctx context.Context, diags *diag.Diagnostics) *lidarr.AddArtistOptions {
+	addOptions := ArtistAddOptions{}
+	diags.Append(a.AddOptions.As(ctx, &addOptions, basetypes.ObjectAsOptions{})...)
+
+	options := lidarr.NewAddArtistOptions()
+	options.SetMonitored(a.Monitored.ValueBool())
+
+	if !addOptions.Monitor.IsNull() && !addOptions.Monitor.IsUnknown() {
+		options.SetMonitor(lidarr.MonitorTypes(addOptions.Monitor.ValueString()))
+	}
+
+	if !addOptions.SearchForMissingAlbums.IsNull() && !addOptions.SearchForMissingAlbums.IsUnknown() {
+		options.SetSearchForMissingAlbums(addOptions.SearchForMissingAlbums.ValueBool())
+	}
+
+	diags.Append(addOptions.AlbumsToMonitor.ElementsAs(ctx, &options.AlbumsToMonitor, true)...)
+
+	return options
+}
+
+// artistIDByName returns the ID of the artist with the given foreign artist ID.
+func artistIDByName(auth context.Context, client *lidarr.APIClient, name string) (int32, error) {
+	response, _, err := client.ArtistAPI.ListArtist(auth).MbId(name).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, artistResourceName, err))
+	}
+
+	return helpers.FindIDByName(artistResourceName, "foreign artist ID", name, response, (*lidarr.ArtistResource).GetForeignArtistId, (*lidarr.ArtistResource).GetId)
+}
@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccArtistResource(t *testing.T) {
@@ -30,7 +36,11 @@ func TestAccArtistResource(t *testing.T) {
 					resource.TestCheckResourceAttr("lidarr_artist.test", "artist_name", "Queen"),
 					resource.TestCheckResourceAttr("lidarr_artist.test", "status", "ended"),
 					resource.TestCheckResourceAttr("lidarr_artist.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("lidarr_artist.test", "monitor_new_items", "none"),
 					resource.TestCheckResourceAttrSet("lidarr_artist.test", "genres.0"),
+					resource.TestCheckResourceAttr("lidarr_artist.test", "artist_type", "Group"),
+					resource.TestCheckResourceAttr("lidarr_artist.test", "ended", "true"),
+					resource.TestCheckResourceAttrSet("lidarr_artist.test", "added"),
 				),
 			},
 			// Unauthorized Read
@@ -43,6 +53,8 @@ func TestAccArtistResource(t *testing.T) {
 				Config: testAccArtistResourceConfig("Queen", "test123", "0383dadf-2a4e-4d10-a46a-e9e041da8eb3"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_artist.test", "path", "/config/test123"),
+					resource.TestCheckResourceAttr("lidarr_artist.test", "root_folder_path", "/config"),
+					resource.TestCheckResourceAttr("lidarr_artist.test", "folder_name", "test123"),
 				),
 			},
 			// ImportState testing
@@ -50,6 +62,16 @@ func TestAccArtistResource(t *testing.T) {
 				ResourceName:      "lidarr_artist.test",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// add_options are only used on creation
+				ImportStateVerifyIgnore: []string{"add_options"},
+			},
+			// Root folder path testing
+			{
+				Config: testAccArtistResourceRootFolderConfig("Queen", "0383dadf-2a4e-4d10-a46a-e9e041da8eb3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_artist.test", "root_folder_path", "/config"),
+					resource.TestCheckResourceAttr("lidarr_artist.test", "path", "/config/test123"),
+				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
@@ -65,6 +87,65 @@ func testAccArtistResourceConfig(title, path, foreignID string) string {
 			quality_profile_id = 1
 			metadata_profile_id = 1
 			foreign_artist_id = "%s"
+			monitor_new_items = "none"
+			add_options = {
+				monitor = "none"
+				search_for_missing_albums = false
+			}
 		}
 	`, title, path, foreignID)
 }
+
+func testAccArtistResourceRootFolderConfig(title, foreignID string) string {
+	return fmt.Sprintf(`
+		resource "lidarr_artist" "test" {
+			monitored = false
+			artist_name = "%s"
+			root_folder_path = "/config"
+			quality_profile_id = 1
+			metadata_profile_id = 1
+			foreign_artist_id = "%s"
+			monitor_new_items = "none"
+		}
+	`, title, foreignID)
+}
+
+func TestArtistWriteAdded(t *testing.T) {
+	t.Parallel()
+
+	added := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := map[string]struct {
+		artist   *lidarr.ArtistResource
+		expected types.String
+	}{
+		"added": {
+			artist:   &lidarr.ArtistResource{Added: &added},
+			expected: types.StringValue("2023-01-02T03:04:05Z"),
+		},
+		"missing": {
+			artist:   &lidarr.ArtistResource{},
+			expected: types.StringNull(),
+		},
+		"zero": {
+			artist:   &lidarr.ArtistResource{Added: &time.Time{}},
+			expected: types.StringNull(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				artist Artist
+				diags  diag.Diagnostics
+			)
+
+			artist.write(context.Background(), test.artist, &diags)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.expected, artist.Added)
+		})
+	}
+}
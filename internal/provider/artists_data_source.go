@@ -75,6 +75,14 @@ func (d *ArtistsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 							MarkdownDescription: "Full artist path.",
 							Computed:            true,
 						},
+						"root_folder_path": schema.StringAttribute{
+							MarkdownDescription: "Root folder path.",
+							Computed:            true,
+						},
+						"folder_name": schema.StringAttribute{
+							MarkdownDescription: "Artist folder name.",
+							Computed:            true,
+						},
 						"status": schema.StringAttribute{
 							MarkdownDescription: "Artist status.",
 							Computed:            true,
@@ -83,6 +91,10 @@ func (d *ArtistsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 							MarkdownDescription: "Overview.",
 							Computed:            true,
 						},
+						"monitor_new_items": schema.StringAttribute{
+							MarkdownDescription: "Monitor new items.",
+							Computed:            true,
+						},
 						"foreign_artist_id": schema.StringAttribute{
 							MarkdownDescription: "Foreign artist ID.",
 							Computed:            true,
@@ -97,6 +109,64 @@ func (d *ArtistsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 							Computed:            true,
 							ElementType:         types.StringType,
 						},
+						"artist_type": schema.StringAttribute{
+							MarkdownDescription: "Artist type.",
+							Computed:            true,
+						},
+						"disambiguation": schema.StringAttribute{
+							MarkdownDescription: "Disambiguation.",
+							Computed:            true,
+						},
+						"sort_name": schema.StringAttribute{
+							MarkdownDescription: "Sort name.",
+							Computed:            true,
+						},
+						"added": schema.StringAttribute{
+							MarkdownDescription: "Added date.",
+							Computed:            true,
+						},
+						"ended": schema.BoolAttribute{
+							MarkdownDescription: "Ended flag.",
+							Computed:            true,
+						},
+						"tadb_id": schema.Int64Attribute{
+							MarkdownDescription: "TADB ID.",
+							Computed:            true,
+						},
+						"discogs_id": schema.Int64Attribute{
+							MarkdownDescription: "Discogs ID.",
+							Computed:            true,
+						},
+						"links": schema.SetNestedAttribute{
+							MarkdownDescription: "External links.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Link name.",
+										Computed:            true,
+									},
+									"url": schema.StringAttribute{
+										MarkdownDescription: "Link URL.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"ratings": schema.SingleNestedAttribute{
+							MarkdownDescription: "Ratings.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"votes": schema.Int64Attribute{
+									MarkdownDescription: "Votes.",
+									Computed:            true,
+								},
+								"value": schema.Float64Attribute{
+									MarkdownDescription: "Value.",
+									Computed:            true,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+const backupActionName = "backup"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &BackupAction{}
+	_ action.ActionWithConfigure = &BackupAction{}
+)
+
+func NewBackupAction() action.Action {
+	return &BackupAction{}
+}
+
+// BackupAction defines the backup implementation.
+type BackupAction struct {
+	commandAction
+}
+
+func (a *BackupAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupActionName
+}
+
+func (a *BackupAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nBackup action, running the `Backup` command.\nFor more information refer to [Backups](https://wiki.servarr.com/lidarr/system#backup) documentation.",
+		Attributes:          commandActionAttributes(map[string]schema.Attribute{}),
+	}
+}
+
+func (a *BackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var options CommandActionOptions
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &options)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a.invoke(ctx, "Backup", nil, options, resp)
+}
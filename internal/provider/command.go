@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// commandPollInterval is the interval between two checks of a running command.
+	commandPollInterval = 2 * time.Second
+	// defaultCommandTimeout is the default timeout in seconds when waiting for a command.
+	defaultCommandTimeout = 300
+)
+
+// createCommand queues the named command. The API client model does not support
+// the command specific arguments, so the commands having any are posted as raw JSON.
+func createCommand(auth context.Context, client *lidarr.APIClient, name string, arguments map[string]any) (*lidarr.CommandResource, error) {
+	if len(arguments) == 0 {
+		request := lidarr.NewCommandResource()
+		request.SetName(name)
+
+		response, _, err := client.CommandAPI.CreateCommand(auth).CommandResource(*request).Execute()
+
+		return response, err
+	}
+
+	body := maps.Clone(arguments)
+	body["name"] = name
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	config := client.GetConfig()
+
+	basePath, err := config.ServerURLWithContext(auth, "CommandAPIService.CreateCommand")
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(auth, http.MethodPost, basePath+"/api/v1/command", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", config.UserAgent)
+
+	for key, value := range config.DefaultHeader {
+		request.Header.Set(key, value)
+	}
+
+	if keys, ok := auth.Value(lidarr.ContextAPIKeys).(map[string]lidarr.APIKey); ok {
+		request.Header.Set("X-Api-Key", keys["X-Api-Key"].Key)
+	}
+
+	response, err := config.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		return nil, helpers.NewResponseError(response, content)
+	}
+
+	command := lidarr.NewCommandResource()
+
+	return command, json.Unmarshal(content, command)
+}
+
+// isCommandEnded identifies whether the command reached a final status.
+func isCommandEnded(status lidarr.CommandStatus) bool {
+	switch status {
+	case lidarr.COMMANDSTATUS_QUEUED, lidarr.COMMANDSTATUS_STARTED:
+		return false
+	default:
+		return true
+	}
+}
+
+// waitForCommand polls the command at the given interval until it ends or the timeout expires, returning its last known value.
+// An error is reported when the command does not complete successfully.
+func waitForCommand(ctx context.Context, command *lidarr.CommandResource, timeout, interval time.Duration, get func() (*lidarr.CommandResource, error), diags *diag.Diagnostics) *lidarr.CommandResource {
+	deadline := time.Now().Add(timeout)
+
+	for !isCommandEnded(command.GetStatus()) {
+		current, err := get()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, commandResourceName, err))
+
+			return command
+		}
+
+		command = current
+		if isCommandEnded(command.GetStatus()) {
+			break
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			diags.AddError(helpers.ClientError, fmt.Sprintf("Timed out after %s waiting for %s %s, last status %s", timeout, commandResourceName, command.GetName(), command.GetStatus()))
+
+			return command
+		}
+
+		tflog.Trace(ctx, "waiting for "+commandResourceName+": "+strconv.Itoa(int(command.GetId())))
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(helpers.ClientError, fmt.Sprintf("Stopped waiting for %s %s: %s", commandResourceName, command.GetName(), ctx.Err()))
+
+			return command
+		case <-time.After(interval):
+		}
+	}
+
+	if command.GetStatus() != lidarr.COMMANDSTATUS_COMPLETED {
+		diags.AddError(helpers.ClientError, fmt.Sprintf("Command %s ended with status %s: %s", command.GetName(), command.GetStatus(), command.GetMessage()))
+	}
+
+	return command
+}
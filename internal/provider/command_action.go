@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// commandAction defines the implementation shared by the actions running a command.
+type commandAction struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// CommandActionOptions describes the command action data model.
+type CommandActionOptions struct {
+	Timeout           types.Int64 `tfsdk:"timeout"`
+	WaitForCompletion types.Bool  `tfsdk:"wait_for_completion"`
+}
+
+// commandActionAttributes adds the wait options to the given action attributes.
+func commandActionAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["wait_for_completion"] = schema.BoolAttribute{
+		MarkdownDescription: "Wait for the command to end. Defaults to `false`.",
+		Optional:            true,
+	}
+	attributes["timeout"] = schema.Int64Attribute{
+		MarkdownDescription: "Timeout in seconds when waiting for the command. Defaults to `300`.",
+		Optional:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+
+	return attributes
+}
+
+func (a *commandAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if auth, client := actionConfigure(ctx, req, resp); client != nil {
+		a.client = client
+		a.auth = auth
+	}
+}
+
+// invoke runs the named command, optionally waiting for its completion, and reports its progress.
+func (a *commandAction) invoke(ctx context.Context, name string, arguments map[string]any, options CommandActionOptions, resp *action.InvokeResponse) {
+	command, err := createCommand(a.auth, a.client, name, arguments)
+	if err != nil {
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, commandResourceName, err, noValidationAttribute)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+commandResourceName+": "+strconv.Itoa(int(command.GetId())))
+	sendCommandProgress(resp, command)
+
+	if !options.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := time.Duration(int64ValueOrDefault(options.Timeout, defaultCommandTimeout)) * time.Second
+	waitForCommand(ctx, command, timeout, commandPollInterval, func() (*lidarr.CommandResource, error) {
+		current, _, err := a.client.CommandAPI.GetCommandById(a.auth, command.GetId()).Execute()
+		if err == nil && current.GetStatus() != command.GetStatus() {
+			command = current
+			sendCommandProgress(resp, command)
+		}
+
+		return current, err
+	}, &resp.Diagnostics)
+}
+
+// sendCommandProgress reports the command status to Terraform.
+func sendCommandProgress(resp *action.InvokeResponse, command *lidarr.CommandResource) {
+	message := fmt.Sprintf("%s %s", command.GetName(), command.GetStatus())
+	if command.GetMessage() != "" {
+		message += ": " + command.GetMessage()
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/testserver"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandActions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		action    action.Action
+		config    map[string]tftypes.Value
+		command   string
+		arguments map[string]any
+		progress  []string
+		diag      string
+	}{
+		"refresh artist": {
+			action: &RefreshArtistAction{},
+			config: map[string]tftypes.Value{
+				"artist_ids":          tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{tftypes.NewValue(tftypes.Number, 1)}),
+				"wait_for_completion": tftypes.NewValue(tftypes.Bool, true),
+			},
+			command:   "RefreshArtist",
+			arguments: map[string]any{"artistIds": []any{float64(1)}},
+			progress:  []string{"RefreshArtist queued", "RefreshArtist completed: Completed"},
+		},
+		"rescan root folder": {
+			action: &RescanRootFolderAction{},
+			config: map[string]tftypes.Value{
+				"folders": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "/music")}),
+			},
+			command:   "RescanFolders",
+			arguments: map[string]any{"folders": []any{"/music"}},
+			progress:  []string{"RescanFolders queued"},
+		},
+		"rescan all root folders": {
+			action:   &RescanRootFolderAction{},
+			command:  "RescanFolders",
+			progress: []string{"RescanFolders queued"},
+		},
+		"rss sync": {
+			action:   &RssSyncAction{},
+			config:   map[string]tftypes.Value{"wait_for_completion": tftypes.NewValue(tftypes.Bool, true)},
+			command:  "RssSync",
+			progress: []string{"RssSync queued", "RssSync completed: Completed"},
+		},
+		"search missing": {
+			action:   &SearchMissingAction{},
+			command:  "MissingAlbumSearch",
+			progress: []string{"MissingAlbumSearch queued"},
+		},
+		"backup": {
+			action:   &BackupAction{},
+			config:   map[string]tftypes.Value{"timeout": tftypes.NewValue(tftypes.Number, 10)},
+			command:  "Backup",
+			progress: []string{"Backup queued"},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testserver.New("key")
+			t.Cleanup(server.Close)
+
+			ctx := context.Background()
+			testConfigureAction(ctx, t, test.action, server)
+
+			var progress []string
+
+			resp := action.InvokeResponse{SendProgress: func(event action.InvokeProgressEvent) {
+				progress = append(progress, event.Message)
+			}}
+			test.action.Invoke(ctx, action.InvokeRequest{Config: testActionConfig(ctx, t, test.action, test.config)}, &resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, test.progress, progress)
+
+			body := testCommandBody(t, server)
+			assert.Equal(t, test.command, body["name"])
+
+			for key, value := range test.arguments {
+				assert.Equal(t, value, body[key])
+			}
+
+			if test.arguments == nil {
+				assert.Len(t, body, 1)
+			}
+		})
+	}
+}
+
+func TestCommandActionError(t *testing.T) {
+	t.Parallel()
+
+	server := testserver.New("key")
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	refresh := &RefreshArtistAction{}
+	testConfigureAction(ctx, t, refresh, server)
+
+	// An invalid API key makes the raw request fail.
+	refresh.auth = context.WithValue(refresh.auth, lidarr.ContextAPIKeys, map[string]lidarr.APIKey{"X-Api-Key": {Key: "wrong"}})
+
+	resp := action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+	refresh.Invoke(ctx, action.InvokeRequest{Config: testActionConfig(ctx, t, refresh, map[string]tftypes.Value{
+		"artist_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{tftypes.NewValue(tftypes.Number, 1)}),
+	})}, &resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "Unable to create command, got error: 401 Unauthorized")
+}
+
+// testConfigureAction configures the action to call the given fake API.
+func testConfigureAction(ctx context.Context, t *testing.T, a action.Action, server *testserver.Server) {
+	t.Helper()
+
+	apiURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	config := lidarr.NewConfiguration()
+	config.HTTPClient = server.Client()
+
+	var resp action.ConfigureResponse
+
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: &LidarrData{
+		Auth:   helpers.NewAuthContext(ctx, apiURL, server.APIKey),
+		Client: lidarr.NewAPIClient(config),
+	}}, &resp)
+	assert.False(t, resp.Diagnostics.HasError())
+}
+
+// testActionConfig returns the action configuration, with null values for the attributes not given.
+func testActionConfig(ctx context.Context, t *testing.T, a action.Action, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var resp action.SchemaResponse
+
+	a.Schema(ctx, action.SchemaRequest{}, &resp)
+	objectType := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	return tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+// testCommandBody returns the body of the first command received by the fake API.
+func testCommandBody(t *testing.T, server *testserver.Server) map[string]any {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/command/1", nil)
+	assert.NoError(t, err)
+	req.Header.Set("X-Api-Key", server.APIKey)
+
+	resp, err := server.Client().Do(req)
+	assert.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	var command map[string]any
+
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&command))
+
+	return command["body"].(map[string]any)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCommandResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCommandResourceConfig("RssSync", "1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Failed command
+			{
+				Config:      testAccCommandResourceConfig("InvalidCommand", "1"),
+				ExpectError: regexp.MustCompile("Command InvalidCommand ended with status failed"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCommandResourceConfig("RssSync", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_command.test", "status", "completed"),
+					resource.TestCheckResourceAttr("lidarr_command.test", "message", "Completed"),
+					resource.TestCheckResourceAttrSet("lidarr_command.test", "duration"),
+					resource.TestCheckResourceAttrSet("lidarr_command.test", "id"),
+				),
+			},
+			// Run again on trigger change
+			{
+				Config: testAccCommandResourceConfig("RssSync", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_command.test", "triggers.run", "2"),
+					resource.TestCheckResourceAttr("lidarr_command.test", "status", "completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCommandResourceConfig(name, run string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_command" "test" {
+		name = "%s"
+		wait_for_completion = true
+		triggers = {
+			run = "%s"
+		}
+	}`, name, run)
+}
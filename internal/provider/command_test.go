@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		statuses []lidarr.CommandStatus
+		err      error
+		expected lidarr.CommandStatus
+		diag     string
+	}{
+		"completed": {
+			statuses: []lidarr.CommandStatus{lidarr.COMMANDSTATUS_STARTED, lidarr.COMMANDSTATUS_COMPLETED},
+			expected: lidarr.COMMANDSTATUS_COMPLETED,
+		},
+		"failed": {
+			statuses: []lidarr.CommandStatus{lidarr.COMMANDSTATUS_FAILED},
+			expected: lidarr.COMMANDSTATUS_FAILED,
+			diag:     "Command RssSync ended with status failed: Boom",
+		},
+		"timeout": {
+			statuses: []lidarr.CommandStatus{lidarr.COMMANDSTATUS_STARTED, lidarr.COMMANDSTATUS_STARTED, lidarr.COMMANDSTATUS_STARTED, lidarr.COMMANDSTATUS_STARTED},
+			expected: lidarr.COMMANDSTATUS_STARTED,
+			diag:     "Timed out after 30ms waiting for command RssSync, last status started",
+		},
+		"error": {
+			err:      errors.New("connection refused"),
+			expected: lidarr.COMMANDSTATUS_QUEUED,
+			diag:     "Unable to read command",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			command := lidarr.NewCommandResource()
+			command.SetName("RssSync")
+			command.SetStatus(lidarr.COMMANDSTATUS_QUEUED)
+
+			calls := 0
+			get := func() (*lidarr.CommandResource, error) {
+				if test.err != nil {
+					return nil, test.err
+				}
+
+				current := lidarr.NewCommandResource()
+				current.SetName("RssSync")
+				current.SetMessage("Boom")
+				current.SetStatus(test.statuses[min(calls, len(test.statuses)-1)])
+				calls++
+
+				return current, nil
+			}
+
+			var diags diag.Diagnostics
+
+			command = waitForCommand(context.Background(), command, 30*time.Millisecond, 10*time.Millisecond, get, &diags)
+			assert.Equal(t, test.expected, command.GetStatus())
+
+			if test.diag == "" {
+				assert.False(t, diags.HasError())
+
+				return
+			}
+
+			assert.Contains(t, diags[0].Detail(), test.diag)
+		})
+	}
+}
+
+func TestCreateCommandValidationError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`[{"propertyName":"ArtistIds","errorMessage":"Artist does not exist","severity":"error"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	config := lidarr.NewConfiguration()
+	config.HTTPClient = server.Client()
+
+	_, err = createCommand(helpers.NewAuthContext(context.Background(), apiURL, "key"), lidarr.NewAPIClient(config), "RefreshArtist", map[string]any{"artistIds": []int{99}})
+	assert.Equal(t, []helpers.ValidationFailure{{PropertyName: "ArtistIds", ErrorMessage: "Artist does not exist", Severity: "error"}}, helpers.ParseValidationFailures(err))
+
+	var diags diag.Diagnostics
+
+	helpers.AddClientError(&diags, helpers.Create, commandResourceName, err, noValidationAttribute)
+	assert.Equal(t, diag.Diagnostics{
+		diag.NewErrorDiagnostic(helpers.ClientError, "Unable to create command, got validation failure on ArtistIds: Artist does not exist"),
+	}, diags)
+}
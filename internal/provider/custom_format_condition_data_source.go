@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -16,10 +17,7 @@ import (
 
 const customFormatConditionDataSourceName = "custom_format_condition"
 
-var customFormatFields = helpers.Fields{
-	Strings: []string{"value"},
-	Ints:    []string{"min", "max"},
-}
+//go:generate go run ../../tools/fieldgen -spec fieldspecs/custom_format_condition.json -out custom_format_condition_fields_gen.go
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &CustomFormatConditionDataSource{}
@@ -147,21 +145,21 @@ func (d *CustomFormatConditionDataSource) Read(ctx context.Context, _ datasource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(hash))...)
 }
 
-func (c *CustomFormatCondition) write(ctx context.Context, spec *lidarr.CustomFormatSpecificationSchema) {
+func (c *CustomFormatCondition) write(ctx context.Context, spec *lidarr.CustomFormatSpecificationSchema, diags *diag.Diagnostics) {
 	c.Implementation = types.StringValue(spec.GetImplementation())
 	c.Name = types.StringValue(spec.GetName())
 	c.Negate = types.BoolValue(spec.GetNegate())
 	c.Required = types.BoolValue(spec.GetRequired())
-	helpers.WriteFields(ctx, c, spec.GetFields(), customFormatFields)
+	c.writeFields(spec.GetFields(), diags)
 }
 
-func (c *CustomFormatCondition) read(ctx context.Context) *lidarr.CustomFormatSpecificationSchema {
+func (c *CustomFormatCondition) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.CustomFormatSpecificationSchema {
 	spec := lidarr.NewCustomFormatSpecificationSchema()
 	spec.SetName(c.Name.ValueString())
 	spec.SetImplementation(c.Implementation.ValueString())
 	spec.SetNegate(c.Negate.ValueBool())
 	spec.SetRequired(c.Required.ValueBool())
-	spec.SetFields(helpers.ReadFields(ctx, c, customFormatFields))
+	spec.SetFields(c.readFields(ctx, diags))
 
 	return spec
 }
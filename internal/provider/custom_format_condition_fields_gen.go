@@ -0,0 +1,42 @@
+// Code generated by fieldgen from fieldspecs/custom_format_condition.json; DO NOT EDIT.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// writeFields populates the CustomFormatCondition attributes from the API fields.
+func (c *CustomFormatCondition) writeFields(fields []lidarr.Field, diags *diag.Diagnostics) {
+	for _, field := range fields {
+		var err error
+
+		switch field.GetName() {
+		case "value":
+			c.Value, err = helpers.StringFieldValue(&field, c.Value)
+		case "min":
+			c.Min, err = helpers.Int64FieldValue(&field)
+		case "max":
+			c.Max, err = helpers.Int64FieldValue(&field)
+		}
+
+		if err != nil {
+			diags.AddError(helpers.FieldError, helpers.ParseFieldError(field.GetName(), err))
+		}
+	}
+}
+
+// readFields returns the API fields of the CustomFormatCondition attributes.
+func (c *CustomFormatCondition) readFields(_ context.Context, _ *diag.Diagnostics) []lidarr.Field {
+	var fields []lidarr.Field
+
+	fields = helpers.AppendStringField(fields, "value", c.Value)
+	fields = helpers.AppendInt64Field(fields, "min", c.Min)
+	fields = helpers.AppendInt64Field(fields, "max", c.Max)
+
+	return fields
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
@@ -153,7 +154,7 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 
 	response, _, err := r.client.CustomFormatAPI.CreateCustomFormat(r.auth).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, customFormatResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, customFormatResourceName, err, validationAttribute(customFormatResourceName))
 
 		return
 	}
@@ -178,8 +179,15 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get CustomFormat current value
-	response, _, err := r.client.CustomFormatAPI.GetCustomFormatById(r.auth, int32(format.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.CustomFormatAPI.GetCustomFormatById(r.auth, int32(format.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(customFormatResourceName, format.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatResourceName, err))
 
 		return
@@ -209,7 +217,7 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 
 	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(r.auth, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, customFormatResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, customFormatResourceName, err, validationAttribute(customFormatResourceName))
 
 		return
 	}
@@ -245,7 +253,9 @@ func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *CustomFormatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return customFormatIDByName(r.auth, r.client, name)
+	})
 	tflog.Trace(ctx, "imported "+customFormatResourceName+": "+req.ID)
 }
 
@@ -254,7 +264,7 @@ func (c *CustomFormat) write(ctx context.Context, customFormat *lidarr.CustomFor
 
 	specs := make([]CustomFormatCondition, len(customFormat.Specifications))
 	for n, s := range customFormat.Specifications {
-		specs[n].write(ctx, &s)
+		specs[n].write(ctx, &s, diags)
 	}
 
 	c.ID = types.Int64Value(int64(customFormat.GetId()))
@@ -270,7 +280,7 @@ func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *lidar
 	specs := make([]lidarr.CustomFormatSpecificationSchema, len(specifications))
 
 	for n, s := range specifications {
-		specs[n] = *s.read(ctx)
+		specs[n] = *s.read(ctx, diags)
 	}
 
 	format := lidarr.NewCustomFormatResource()
@@ -281,3 +291,13 @@ func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *lidar
 
 	return format
 }
+
+// customFormatIDByName returns the ID of the custom format with the given name.
+func customFormatIDByName(auth context.Context, client *lidarr.APIClient, name string) (int32, error) {
+	response, _, err := client.CustomFormatAPI.ListCustomFormat(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, customFormatResourceName, err))
+	}
+
+	return helpers.FindIDByName(customFormatResourceName, "name", name, response, (*lidarr.CustomFormatResource).GetName, (*lidarr.CustomFormatResource).GetId)
+}
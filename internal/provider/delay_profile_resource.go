@@ -143,7 +143,7 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new DelayProfile
 	response, _, err := r.client.DelayProfileAPI.CreateDelayProfile(r.auth).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, delayProfileResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, delayProfileResourceName, err, validationAttribute(delayProfileResourceName))
 
 		return
 	}
@@ -156,7 +156,7 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 
 		response, _, err = r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+			helpers.AddClientError(&resp.Diagnostics, helpers.Update, delayProfileResourceName, err, validationAttribute(delayProfileResourceName))
 
 			return
 		}
@@ -178,8 +178,15 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get delayprofile current value
-	response, _, err := r.client.DelayProfileAPI.GetDelayProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DelayProfileAPI.GetDelayProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(delayProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileResourceName, err))
 
 		return
@@ -207,7 +214,7 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update DelayProfile
 	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, delayProfileResourceName, err, validationAttribute(delayProfileResourceName))
 
 		return
 	}
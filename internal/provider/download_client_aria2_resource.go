@@ -35,8 +35,9 @@ func NewDownloadClientAria2Resource() resource.Resource {
 
 // DownloadClientAria2Resource defines the download client implementation.
 type DownloadClientAria2Resource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // DownloadClientAria2 describes the download client data model.
@@ -53,6 +54,7 @@ type DownloadClientAria2 struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
@@ -128,6 +130,7 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -169,6 +172,7 @@ func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -185,9 +189,17 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 	// Create new DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientAria2ResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, downloadClientAria2ResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, downloadClientAria2ResourceName, err, fieldValidationAttribute(downloadClientAria2ResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -209,8 +221,15 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientAria2 current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(downloadClientAria2ResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientAria2ResourceName, err))
 
 		return
@@ -223,10 +242,11 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var client *DownloadClientAria2
+	// Get plan and state values
+	var client, prior *DownloadClientAria2
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -234,10 +254,19 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Fields = client.toDownloadClient().maskFields(request.Fields, prior.toDownloadClient())
+
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientAria2ResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, err := updateDownloadClient(r.auth, r.client, request, downloadClientAria2ResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, downloadClientAria2ResourceName, err, fieldValidationAttribute(downloadClientAria2ResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -270,7 +299,9 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientAria2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return downloadClientIDByName(r.auth, r.client, name, downloadClientAria2Implementation)
+	})
 	tflog.Trace(ctx, "imported "+downloadClientAria2ResourceName+": "+req.ID)
 }
 
@@ -37,8 +37,9 @@ func NewDownloadClientDelugeResource() resource.Resource {
 
 // DownloadClientDelugeResource defines the download client implementation.
 type DownloadClientDelugeResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // DownloadClientDeluge describes the download client data model.
@@ -60,6 +61,7 @@ type DownloadClientDeluge struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
@@ -145,6 +147,7 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -218,6 +221,7 @@ func (r *DownloadClientDelugeResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -234,9 +238,17 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 	// Create new DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientDelugeResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, downloadClientDelugeResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, downloadClientDelugeResourceName, err, fieldValidationAttribute(downloadClientDelugeResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -258,8 +270,15 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientDeluge current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(downloadClientDelugeResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDelugeResourceName, err))
 
 		return
@@ -272,10 +291,11 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var client *DownloadClientDeluge
+	// Get plan and state values
+	var client, prior *DownloadClientDeluge
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -283,10 +303,19 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Fields = client.toDownloadClient().maskFields(request.Fields, prior.toDownloadClient())
+
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientDelugeResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, err := updateDownloadClient(r.auth, r.client, request, downloadClientDelugeResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, downloadClientDelugeResourceName, err, fieldValidationAttribute(downloadClientDelugeResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -319,7 +348,9 @@ func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientDelugeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return downloadClientIDByName(r.auth, r.client, name, downloadClientDelugeImplementation)
+	})
 	tflog.Trace(ctx, "imported "+downloadClientDelugeResourceName+": "+req.ID)
 }
 
@@ -0,0 +1,513 @@
+// Code generated by fieldgen from fieldspecs/download_client.json; DO NOT EDIT.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeFields populates the DownloadClient attributes from the API fields.
+func (d *DownloadClient) writeFields(fields []lidarr.Field, diags *diag.Diagnostics) {
+	for _, field := range fields {
+		var err error
+
+		switch field.GetName() {
+		case "addPaused":
+			d.AddPaused, err = helpers.BoolFieldValue(&field)
+		case "useSsl":
+			d.UseSsl, err = helpers.BoolFieldValue(&field)
+		case "startOnAdd":
+			d.StartOnAdd, err = helpers.BoolFieldValue(&field)
+		case "sequentialOrder":
+			d.SequentialOrder, err = helpers.BoolFieldValue(&field)
+		case "firstAndLast":
+			d.FirstAndLast, err = helpers.BoolFieldValue(&field)
+		case "addStopped":
+			d.AddStopped, err = helpers.BoolFieldValue(&field)
+		case "saveMagnetFiles":
+			d.SaveMagnetFiles, err = helpers.BoolFieldValue(&field)
+		case "readOnly":
+			d.ReadOnly, err = helpers.BoolFieldValue(&field)
+		case "port":
+			d.Port, err = helpers.Int64FieldValue(&field)
+		case "recentMusicPriority":
+			d.RecentMusicPriority, err = helpers.Int64FieldValue(&field)
+		case "olderMusicPriority":
+			d.OlderMusicPriority, err = helpers.Int64FieldValue(&field)
+		case "initialState":
+			d.InitialState, err = helpers.Int64FieldValue(&field)
+		case "intialState":
+			d.IntialState, err = helpers.Int64FieldValue(&field)
+		case "host":
+			d.Host, err = helpers.StringFieldValue(&field, d.Host)
+		case "apiKey":
+			d.APIKey, err = helpers.SensitiveFieldValue(&field, d.APIKey)
+		case "rpcPath":
+			d.RPCPath, err = helpers.StringFieldValue(&field, d.RPCPath)
+		case "urlBase":
+			d.URLBase, err = helpers.StringFieldValue(&field, d.URLBase)
+		case "secretToken":
+			d.SecretToken, err = helpers.SensitiveFieldValue(&field, d.SecretToken)
+		case "username":
+			d.Username, err = helpers.StringFieldValue(&field, d.Username)
+		case "password":
+			d.Password, err = helpers.SensitiveFieldValue(&field, d.Password)
+		case "musicCategory":
+			d.MusicCategory, err = helpers.StringFieldValue(&field, d.MusicCategory)
+		case "musicImportedCategory":
+			d.MusicImportedCategory, err = helpers.StringFieldValue(&field, d.MusicImportedCategory)
+		case "musicDirectory":
+			d.MusicDirectory, err = helpers.StringFieldValue(&field, d.MusicDirectory)
+		case "destination":
+			d.Destination, err = helpers.StringFieldValue(&field, d.Destination)
+		case "category":
+			d.Category, err = helpers.StringFieldValue(&field, d.Category)
+		case "nzbFolder":
+			d.NzbFolder, err = helpers.StringFieldValue(&field, d.NzbFolder)
+		case "strmFolder":
+			d.StrmFolder, err = helpers.StringFieldValue(&field, d.StrmFolder)
+		case "torrentFolder":
+			d.TorrentFolder, err = helpers.StringFieldValue(&field, d.TorrentFolder)
+		case "magnetFileExtension":
+			d.MagnetFileExtension, err = helpers.StringFieldValue(&field, d.MagnetFileExtension)
+		case "watchFolder":
+			d.WatchFolder, err = helpers.StringFieldValue(&field, d.WatchFolder)
+		case "additionalTags":
+			d.AdditionalTags, err = helpers.Int64SetFieldValue(&field)
+		case "tags":
+			d.FieldTags, err = helpers.StringSetFieldValue(&field)
+		case "postImportTags":
+			d.PostImportTags, err = helpers.StringSetFieldValue(&field)
+		}
+
+		if err != nil {
+			diags.AddError(helpers.FieldError, helpers.ParseFieldError(field.GetName(), err))
+		}
+	}
+}
+
+// readFields returns the API fields of the DownloadClient attributes.
+func (d *DownloadClient) readFields(ctx context.Context, diags *diag.Diagnostics) []lidarr.Field {
+	var fields []lidarr.Field
+
+	fields = helpers.AppendBoolField(fields, "addPaused", d.AddPaused)
+	fields = helpers.AppendBoolField(fields, "useSsl", d.UseSsl)
+	fields = helpers.AppendBoolField(fields, "startOnAdd", d.StartOnAdd)
+	fields = helpers.AppendBoolField(fields, "sequentialOrder", d.SequentialOrder)
+	fields = helpers.AppendBoolField(fields, "firstAndLast", d.FirstAndLast)
+	fields = helpers.AppendBoolField(fields, "addStopped", d.AddStopped)
+	fields = helpers.AppendBoolField(fields, "saveMagnetFiles", d.SaveMagnetFiles)
+	fields = helpers.AppendBoolField(fields, "readOnly", d.ReadOnly)
+	fields = helpers.AppendInt64Field(fields, "port", d.Port)
+	fields = helpers.AppendInt64Field(fields, "recentMusicPriority", d.RecentMusicPriority)
+	fields = helpers.AppendInt64Field(fields, "olderMusicPriority", d.OlderMusicPriority)
+	fields = helpers.AppendInt64Field(fields, "initialState", d.InitialState)
+	fields = helpers.AppendInt64Field(fields, "intialState", d.IntialState)
+	fields = helpers.AppendStringField(fields, "host", d.Host)
+	fields = helpers.AppendSensitiveField(fields, "apiKey", d.APIKey)
+	fields = helpers.AppendStringField(fields, "rpcPath", d.RPCPath)
+	fields = helpers.AppendStringField(fields, "urlBase", d.URLBase)
+	fields = helpers.AppendSensitiveField(fields, "secretToken", d.SecretToken)
+	fields = helpers.AppendStringField(fields, "username", d.Username)
+	fields = helpers.AppendSensitiveField(fields, "password", d.Password)
+	fields = helpers.AppendStringField(fields, "musicCategory", d.MusicCategory)
+	fields = helpers.AppendStringField(fields, "musicImportedCategory", d.MusicImportedCategory)
+	fields = helpers.AppendStringField(fields, "musicDirectory", d.MusicDirectory)
+	fields = helpers.AppendStringField(fields, "destination", d.Destination)
+	fields = helpers.AppendStringField(fields, "category", d.Category)
+	fields = helpers.AppendStringField(fields, "nzbFolder", d.NzbFolder)
+	fields = helpers.AppendStringField(fields, "strmFolder", d.StrmFolder)
+	fields = helpers.AppendStringField(fields, "torrentFolder", d.TorrentFolder)
+	fields = helpers.AppendStringField(fields, "magnetFileExtension", d.MagnetFileExtension)
+	fields = helpers.AppendStringField(fields, "watchFolder", d.WatchFolder)
+	fields = helpers.AppendInt64SetField(ctx, fields, "additionalTags", d.AdditionalTags, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "tags", d.FieldTags, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "postImportTags", d.PostImportTags, diags)
+
+	return fields
+}
+
+// maskFields appends the masked value of the secrets set in the prior state but not in the plan, so that the API keeps them.
+func (d *DownloadClient) maskFields(fields []lidarr.Field, prior *DownloadClient) []lidarr.Field {
+	fields = helpers.AppendMaskedField(fields, "apiKey", d.APIKey, prior.APIKey)
+	fields = helpers.AppendMaskedField(fields, "secretToken", d.SecretToken, prior.SecretToken)
+	fields = helpers.AppendMaskedField(fields, "password", d.Password, prior.Password)
+
+	return fields
+}
+
+// withDownloadClientFieldAttributes adds the field attributes to the DownloadClient resource schema.
+func withDownloadClientFieldAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["add_paused"] = schema.BoolAttribute{
+		MarkdownDescription: "Add paused flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["use_ssl"] = schema.BoolAttribute{
+		MarkdownDescription: "Use SSL flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["start_on_add"] = schema.BoolAttribute{
+		MarkdownDescription: "Start on add flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["sequential_order"] = schema.BoolAttribute{
+		MarkdownDescription: "Sequential order flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["first_and_last"] = schema.BoolAttribute{
+		MarkdownDescription: "First and last flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["add_stopped"] = schema.BoolAttribute{
+		MarkdownDescription: "Add stopped flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["save_magnet_files"] = schema.BoolAttribute{
+		MarkdownDescription: "Save magnet files flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["read_only"] = schema.BoolAttribute{
+		MarkdownDescription: "Read only flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["port"] = schema.Int64Attribute{
+		MarkdownDescription: "Port.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["recent_music_priority"] = schema.Int64Attribute{
+		MarkdownDescription: "Recent Music priority. `0` Last, `1` First.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.OneOf(0, 1),
+		},
+	}
+	attributes["older_music_priority"] = schema.Int64Attribute{
+		MarkdownDescription: "Older Music priority. `0` Last, `1` First.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.OneOf(0, 1),
+		},
+	}
+	attributes["initial_state"] = schema.Int64Attribute{
+		MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.OneOf(0, 1),
+		},
+	}
+	attributes["intial_state"] = schema.Int64Attribute{
+		MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["host"] = schema.StringAttribute{
+		MarkdownDescription: "host.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["api_key"] = schema.StringAttribute{
+		MarkdownDescription: "API key.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["rpc_path"] = schema.StringAttribute{
+		MarkdownDescription: "RPC path.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["url_base"] = schema.StringAttribute{
+		MarkdownDescription: "Base URL.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["secret_token"] = schema.StringAttribute{
+		MarkdownDescription: "Secret token.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["username"] = schema.StringAttribute{
+		MarkdownDescription: "Username.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["password"] = schema.StringAttribute{
+		MarkdownDescription: "Password.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["music_category"] = schema.StringAttribute{
+		MarkdownDescription: "Music category.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["music_imported_category"] = schema.StringAttribute{
+		MarkdownDescription: "Music imported category.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["music_directory"] = schema.StringAttribute{
+		MarkdownDescription: "Music directory.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["destination"] = schema.StringAttribute{
+		MarkdownDescription: "Destination.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["category"] = schema.StringAttribute{
+		MarkdownDescription: "Category.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["nzb_folder"] = schema.StringAttribute{
+		MarkdownDescription: "NZB folder.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["strm_folder"] = schema.StringAttribute{
+		MarkdownDescription: "STRM folder.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["torrent_folder"] = schema.StringAttribute{
+		MarkdownDescription: "Torrent folder.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["magnet_file_extension"] = schema.StringAttribute{
+		MarkdownDescription: "Magnet file extension.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["watch_folder"] = schema.StringAttribute{
+		MarkdownDescription: "Watch folder flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["additional_tags"] = schema.SetAttribute{
+		MarkdownDescription: "Additional tags, `0` TitleSlug, `1` Quality, `2` Language, `3` ReleaseGroup, `4` Year, `5` Indexer, `6` Network.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["field_tags"] = schema.SetAttribute{
+		MarkdownDescription: "Field tags.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["post_import_tags"] = schema.SetAttribute{
+		MarkdownDescription: "Post import tags.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+
+	return attributes
+}
+
+// withDownloadClientFieldDataSourceAttributes adds the field attributes to the DownloadClient data source schemas.
+func withDownloadClientFieldDataSourceAttributes(attributes map[string]dataSourceSchema.Attribute) map[string]dataSourceSchema.Attribute {
+	attributes["add_paused"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Add paused flag.",
+		Computed:            true,
+	}
+	attributes["use_ssl"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Use SSL flag.",
+		Computed:            true,
+	}
+	attributes["start_on_add"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Start on add flag.",
+		Computed:            true,
+	}
+	attributes["sequential_order"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Sequential order flag.",
+		Computed:            true,
+	}
+	attributes["first_and_last"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "First and last flag.",
+		Computed:            true,
+	}
+	attributes["add_stopped"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Add stopped flag.",
+		Computed:            true,
+	}
+	attributes["save_magnet_files"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Save magnet files flag.",
+		Computed:            true,
+	}
+	attributes["read_only"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Read only flag.",
+		Computed:            true,
+	}
+	attributes["port"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Port.",
+		Computed:            true,
+	}
+	attributes["recent_music_priority"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Recent Music priority. `0` Last, `1` First.",
+		Computed:            true,
+	}
+	attributes["older_music_priority"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Older Music priority. `0` Last, `1` First.",
+		Computed:            true,
+	}
+	attributes["initial_state"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
+		Computed:            true,
+	}
+	attributes["intial_state"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
+		Computed:            true,
+	}
+	attributes["host"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "host.",
+		Computed:            true,
+	}
+	attributes["api_key"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "API key.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["rpc_path"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "RPC path.",
+		Computed:            true,
+	}
+	attributes["url_base"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Base URL.",
+		Computed:            true,
+	}
+	attributes["secret_token"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Secret token.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["username"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Username.",
+		Computed:            true,
+	}
+	attributes["password"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Password.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["music_category"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Music category.",
+		Computed:            true,
+	}
+	attributes["music_imported_category"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Music imported category.",
+		Computed:            true,
+	}
+	attributes["music_directory"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Music directory.",
+		Computed:            true,
+	}
+	attributes["destination"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Destination.",
+		Computed:            true,
+	}
+	attributes["category"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Category.",
+		Computed:            true,
+	}
+	attributes["nzb_folder"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "NZB folder.",
+		Computed:            true,
+	}
+	attributes["strm_folder"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "STRM folder.",
+		Computed:            true,
+	}
+	attributes["torrent_folder"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Torrent folder.",
+		Computed:            true,
+	}
+	attributes["magnet_file_extension"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Magnet file extension.",
+		Computed:            true,
+	}
+	attributes["watch_folder"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Watch folder flag.",
+		Computed:            true,
+	}
+	attributes["additional_tags"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Additional tags, `0` TitleSlug, `1` Quality, `2` Language, `3` ReleaseGroup, `4` Year, `5` Indexer, `6` Network.",
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["field_tags"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Field tags.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["post_import_tags"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Post import tags.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+
+	return attributes
+}
+
+// downloadClientFieldNames maps the API field names to the DownloadClient attributes managing them.
+var downloadClientFieldNames = map[string]string{
+	"addPaused":             "add_paused",
+	"useSsl":                "use_ssl",
+	"startOnAdd":            "start_on_add",
+	"sequentialOrder":       "sequential_order",
+	"firstAndLast":          "first_and_last",
+	"addStopped":            "add_stopped",
+	"saveMagnetFiles":       "save_magnet_files",
+	"readOnly":              "read_only",
+	"port":                  "port",
+	"recentMusicPriority":   "recent_music_priority",
+	"olderMusicPriority":    "older_music_priority",
+	"initialState":          "initial_state",
+	"intialState":           "intial_state",
+	"host":                  "host",
+	"apiKey":                "api_key",
+	"rpcPath":               "rpc_path",
+	"urlBase":               "url_base",
+	"secretToken":           "secret_token",
+	"username":              "username",
+	"password":              "password",
+	"musicCategory":         "music_category",
+	"musicImportedCategory": "music_imported_category",
+	"musicDirectory":        "music_directory",
+	"destination":           "destination",
+	"category":              "category",
+	"nzbFolder":             "nzb_folder",
+	"strmFolder":            "strm_folder",
+	"torrentFolder":         "torrent_folder",
+	"magnetFileExtension":   "magnet_file_extension",
+	"watchFolder":           "watch_folder",
+	"additionalTags":        "additional_tags",
+	"tags":                  "field_tags",
+	"postImportTags":        "post_import_tags",
+}
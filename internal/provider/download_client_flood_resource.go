@@ -35,8 +35,9 @@ func NewDownloadClientFloodResource() resource.Resource {
 
 // DownloadClientFloodResource defines the download client implementation.
 type DownloadClientFloodResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // DownloadClientFlood describes the download client data model.
@@ -59,6 +60,7 @@ type DownloadClientFlood struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
@@ -146,6 +148,7 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -221,6 +224,7 @@ func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -237,9 +241,17 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientFloodResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, downloadClientFloodResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, downloadClientFloodResourceName, err, fieldValidationAttribute(downloadClientFloodResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -261,8 +273,15 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientFlood current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(downloadClientFloodResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
 
 		return
@@ -275,10 +294,11 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var client *DownloadClientFlood
+	// Get plan and state values
+	var client, prior *DownloadClientFlood
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -286,10 +306,19 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Fields = client.toDownloadClient().maskFields(request.Fields, prior.toDownloadClient())
+
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientFloodResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, err := updateDownloadClient(r.auth, r.client, request, downloadClientFloodResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, downloadClientFloodResourceName, err, fieldValidationAttribute(downloadClientFloodResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -322,7 +351,9 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientFloodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return downloadClientIDByName(r.auth, r.client, name, downloadClientFloodImplementation)
+	})
 	tflog.Trace(ctx, "imported "+downloadClientFloodResourceName+": "+req.ID)
 }
 
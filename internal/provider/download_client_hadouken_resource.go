@@ -35,8 +35,9 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 
 // DownloadClientHadoukenResource defines the download client implementation.
 type DownloadClientHadoukenResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // DownloadClientHadouken describes the download client data model.
@@ -55,6 +56,7 @@ type DownloadClientHadouken struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
@@ -134,6 +136,7 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -184,6 +187,7 @@ func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req reso
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -200,9 +204,17 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientHadoukenResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, downloadClientHadoukenResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, downloadClientHadoukenResourceName, err, fieldValidationAttribute(downloadClientHadoukenResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -224,8 +236,15 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get DownloadClientHadouken current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(downloadClientHadoukenResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
 
 		return
@@ -238,10 +257,11 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var client *DownloadClientHadouken
+	// Get plan and state values
+	var client, prior *DownloadClientHadouken
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -249,10 +269,19 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Fields = client.toDownloadClient().maskFields(request.Fields, prior.toDownloadClient())
+
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientHadoukenResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, err := updateDownloadClient(r.auth, r.client, request, downloadClientHadoukenResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, downloadClientHadoukenResourceName, err, fieldValidationAttribute(downloadClientHadoukenResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -285,7 +314,9 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return downloadClientIDByName(r.auth, r.client, name, downloadClientHadoukenImplementation)
+	})
 	tflog.Trace(ctx, "imported "+downloadClientHadoukenResourceName+": "+req.ID)
 }
 
@@ -37,8 +37,9 @@ func NewDownloadClientNzbgetResource() resource.Resource {
 
 // DownloadClientNzbgetResource defines the download client implementation.
 type DownloadClientNzbgetResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // DownloadClientNzbget describes the download client data model.
@@ -60,6 +61,7 @@ type DownloadClientNzbget struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientNzbget) toDownloadClient() *DownloadClient {
@@ -145,6 +147,7 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -218,6 +221,7 @@ func (r *DownloadClientNzbgetResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -234,9 +238,17 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 	// Create new DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientNzbgetResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, downloadClientNzbgetResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbgetResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, downloadClientNzbgetResourceName, err, fieldValidationAttribute(downloadClientNzbgetResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -258,8 +270,15 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientNzbget current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(downloadClientNzbgetResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientNzbgetResourceName, err))
 
 		return
@@ -272,10 +291,11 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var client *DownloadClientNzbget
+	// Get plan and state values
+	var client, prior *DownloadClientNzbget
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -283,10 +303,19 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 
 	// Update DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Fields = client.toDownloadClient().maskFields(request.Fields, prior.toDownloadClient())
+
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientNzbgetResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	response, err := updateDownloadClient(r.auth, r.client, request, downloadClientNzbgetResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbgetResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, downloadClientNzbgetResourceName, err, fieldValidationAttribute(downloadClientNzbgetResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -319,7 +348,9 @@ func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientNzbgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return downloadClientIDByName(r.auth, r.client, name, downloadClientNzbgetImplementation)
+	})
 	tflog.Trace(ctx, "imported "+downloadClientNzbgetResourceName+": "+req.ID)
 }
 
@@ -2,11 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
-	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -26,16 +26,10 @@ const downloadClientResourceName = "download_client"
 var (
 	_ resource.Resource                = &DownloadClientResource{}
 	_ resource.ResourceWithImportState = &DownloadClientResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientResource{}
 )
 
-var downloadClientFields = helpers.Fields{
-	Bools:                  []string{"addPaused", "useSsl", "startOnAdd", "sequentialOrder", "firstAndLast", "addStopped", "saveMagnetFiles", "readOnly"},
-	Ints:                   []string{"port", "recentMusicPriority", "olderMusicPriority", "initialState", "intialState"},
-	Strings:                []string{"host", "apiKey", "urlBase", "rpcPath", "secretToken", "password", "username", "musicCategory", "musicImportedCategory", "musicDirectory", "destination", "category", "nzbFolder", "strmFolder", "torrentFolder", "magnetFileExtension", "watchFolder"},
-	StringSlices:           []string{"fieldTags", "postImportTags"},
-	StringSlicesExceptions: []string{"tags"},
-	IntSlices:              []string{"additionalTags"},
-}
+//go:generate go run ../../tools/fieldgen -spec fieldspecs/download_client.json -out download_client_fields_gen.go
 
 func NewDownloadClientResource() resource.Resource {
 	return &DownloadClientResource{}
@@ -43,8 +37,9 @@ func NewDownloadClientResource() resource.Resource {
 
 // DownloadClientResource defines the download client implementation.
 type DownloadClientResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // DownloadClient describes the download client data model.
@@ -94,6 +89,14 @@ type DownloadClient struct {
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
 }
 
+// downloadClientResourceModel describes the generic download client resource data model.
+type downloadClientResourceModel struct {
+	Fields          types.Map  `tfsdk:"fields"`
+	SensitiveFields types.Map  `tfsdk:"sensitive_fields"`
+	TestOnApply     types.Bool `tfsdk:"test_on_apply"`
+	DownloadClient
+}
+
 func (d DownloadClient) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -150,7 +153,7 @@ func (r *DownloadClientResource) Metadata(_ context.Context, req resource.Metada
 func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nGeneric Download Client resource. When possible use a specific resource instead.\nFor more information refer to [Download Client](https://wiki.servarr.com/lidarr/settings#download-clients).",
-		Attributes: map[string]schema.Attribute{
+		Attributes: withDownloadClientFieldAttributes(map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -196,6 +199,9 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"fields":           fieldsAttribute(),
+			"sensitive_fields": sensitiveFieldsAttribute(),
+			"test_on_apply":    testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -203,188 +209,7 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			// Field values
-			"add_paused": schema.BoolAttribute{
-				MarkdownDescription: "Add paused flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"use_ssl": schema.BoolAttribute{
-				MarkdownDescription: "Use SSL flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"start_on_add": schema.BoolAttribute{
-				MarkdownDescription: "Start on add flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"sequential_order": schema.BoolAttribute{
-				MarkdownDescription: "Sequential order flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"first_and_last": schema.BoolAttribute{
-				MarkdownDescription: "First and last flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"add_stopped": schema.BoolAttribute{
-				MarkdownDescription: "Add stopped flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"save_magnet_files": schema.BoolAttribute{
-				MarkdownDescription: "Save magnet files flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"read_only": schema.BoolAttribute{
-				MarkdownDescription: "Read only flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"port": schema.Int64Attribute{
-				MarkdownDescription: "Port.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"recent_music_priority": schema.Int64Attribute{
-				MarkdownDescription: "Recent Music priority. `0` Last, `1` First.",
-				Optional:            true,
-				Computed:            true,
-				Validators: []validator.Int64{
-					int64validator.OneOf(0, 1),
-				},
-			},
-			"older_music_priority": schema.Int64Attribute{
-				MarkdownDescription: "Older Music priority. `0` Last, `1` First.",
-				Optional:            true,
-				Computed:            true,
-				Validators: []validator.Int64{
-					int64validator.OneOf(0, 1),
-				},
-			},
-			"initial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
-				Optional:            true,
-				Computed:            true,
-				Validators: []validator.Int64{
-					int64validator.OneOf(0, 1),
-				},
-			},
-			"intial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"host": schema.StringAttribute{
-				MarkdownDescription: "host.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"rpc_path": schema.StringAttribute{
-				MarkdownDescription: "RPC path.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"url_base": schema.StringAttribute{
-				MarkdownDescription: "Base URL.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"secret_token": schema.StringAttribute{
-				MarkdownDescription: "Secret token.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"password": schema.StringAttribute{
-				MarkdownDescription: "Password.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"music_category": schema.StringAttribute{
-				MarkdownDescription: "Music category.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"music_imported_category": schema.StringAttribute{
-				MarkdownDescription: "Music imported category.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"music_directory": schema.StringAttribute{
-				MarkdownDescription: "Music directory.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"destination": schema.StringAttribute{
-				MarkdownDescription: "Destination.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"category": schema.StringAttribute{
-				MarkdownDescription: "Category.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"nzb_folder": schema.StringAttribute{
-				MarkdownDescription: "NZB folder.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"strm_folder": schema.StringAttribute{
-				MarkdownDescription: "STRM folder.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"torrent_folder": schema.StringAttribute{
-				MarkdownDescription: "Torrent folder.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"magnet_file_extension": schema.StringAttribute{
-				MarkdownDescription: "Magnet file extension.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"watch_folder": schema.StringAttribute{
-				MarkdownDescription: "Watch folder flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"additional_tags": schema.SetAttribute{
-				MarkdownDescription: "Additional tags, `0` TitleSlug, `1` Quality, `2` Language, `3` ReleaseGroup, `4` Year, `5` Indexer, `6` Network.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"field_tags": schema.SetAttribute{
-				MarkdownDescription: "Field tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"post_import_tags": schema.SetAttribute{
-				MarkdownDescription: "Post import tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
+		}),
 	}
 }
 
@@ -392,12 +217,30 @@ func (r *DownloadClientResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
+func (r *DownloadClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or if provider is not configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var client *downloadClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+
+	if resp.Diagnostics.HasError() || client.Implementation.IsUnknown() {
+		return
+	}
+
+	r.freeFields(ctx, client, &resp.Diagnostics)
+}
+
 func (r *DownloadClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var client *DownloadClient
+	var client *downloadClientResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -407,10 +250,19 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 
 	// Create new DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, client, &resp.Diagnostics))
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, downloadClientResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, downloadClientResourceName, err, fieldValidationAttribute(downloadClientResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -418,16 +270,19 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state downloadClientResourceModel
 
-	state.writeSensitive(client)
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = client.TestOnApply
+	state.Fields = writeFreeFields(ctx, client.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, client.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var client *DownloadClient
+	var client *downloadClientResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -436,8 +291,15 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get DownloadClient current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(downloadClientResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientResourceName, err))
 
 		return
@@ -446,18 +308,22 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Trace(ctx, "read "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state downloadClientResourceModel
 
-	state.writeSensitive(client)
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = client.TestOnApply
+	state.Fields = writeFreeFields(ctx, client.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, client.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var client *DownloadClient
+	// Get plan and state values
+	var client, prior *downloadClientResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -465,10 +331,20 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 
 	// Update DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
+	request.Fields = client.maskFields(request.Fields, &prior.DownloadClient)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, client, &resp.Diagnostics))
+
+	if isTestOnApply(client.TestOnApply, r.testOnApply) {
+		testDownloadClient(r.auth, r.client, request, downloadClientResourceName, &resp.Diagnostics)
+	}
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateDownloadClient(r.auth, r.client, request, downloadClientResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, downloadClientResourceName, err, fieldValidationAttribute(downloadClientResourceName, downloadClientFieldNames, request.GetFields()))
 
 		return
 	}
@@ -476,10 +352,13 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state downloadClientResourceModel
 
-	state.writeSensitive(client)
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = client.TestOnApply
+	state.Fields = writeFreeFields(ctx, client.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, client.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -505,10 +384,62 @@ func (r *DownloadClientResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *DownloadClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return downloadClientIDByName(r.auth, r.client, name, "")
+	})
 	tflog.Trace(ctx, "imported "+downloadClientResourceName+": "+req.ID)
 }
 
+// testDownloadClient tests the download client connection, reporting the validation failures on the related attributes.
+func testDownloadClient(auth context.Context, client *lidarr.APIClient, downloadClient *lidarr.DownloadClientResource, name string, diags *diag.Diagnostics) {
+	if _, err := client.DownloadClientAPI.TestDownloadClient(auth).DownloadClientResource(*downloadClient).Execute(); err != nil {
+		helpers.AddValidationDiagnostics(diags, helpers.TestConnection, name, err, fieldValidationAttribute(name, downloadClientFieldNames, downloadClient.GetFields()))
+	}
+}
+
+// createDownloadClient creates the download client. When Lidarr only reports validation warnings,
+// they are reported as such and the download client is saved anyway.
+func createDownloadClient(auth context.Context, client *lidarr.APIClient, downloadClient *lidarr.DownloadClientResource, name string, diags *diag.Diagnostics) (*lidarr.DownloadClientResource, error) {
+	response, _, err := client.DownloadClientAPI.CreateDownloadClient(auth).DownloadClientResource(*downloadClient).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Create, name, err, fieldValidationAttribute(name, downloadClientFieldNames, downloadClient.GetFields()))
+		response, _, err = client.DownloadClientAPI.CreateDownloadClient(auth).DownloadClientResource(*downloadClient).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// updateDownloadClient updates the download client. When Lidarr only reports validation warnings,
+// they are reported as such and the download client is saved anyway.
+func updateDownloadClient(auth context.Context, client *lidarr.APIClient, downloadClient *lidarr.DownloadClientResource, name string, diags *diag.Diagnostics) (*lidarr.DownloadClientResource, error) {
+	response, _, err := client.DownloadClientAPI.UpdateDownloadClient(auth, downloadClient.GetId()).DownloadClientResource(*downloadClient).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Update, name, err, fieldValidationAttribute(name, downloadClientFieldNames, downloadClient.GetFields()))
+		response, _, err = client.DownloadClientAPI.UpdateDownloadClient(auth, downloadClient.GetId()).DownloadClientResource(*downloadClient).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// freeFields validates the free-form fields against the implementation schema and returns their API representation.
+func (r *DownloadClientResource) freeFields(ctx context.Context, client *downloadClientResourceModel, diags *diag.Diagnostics) []lidarr.Field {
+	if !hasFreeFields(client.Fields, client.SensitiveFields) {
+		return nil
+	}
+
+	schemas, _, err := r.client.DownloadClientAPI.ListDownloadClientSchema(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, downloadClientResourceName+" schema", err))
+
+		return nil
+	}
+
+	fields, found := schemaFields(schemas, client.Implementation.ValueString())
+	validateImplementation(found, client.Implementation.ValueString(), diags)
+
+	return readFreeFields(ctx, client.Fields, client.SensitiveFields, fields, downloadClientFieldNames, diags)
+}
+
 func (d *DownloadClient) write(ctx context.Context, downloadClient *lidarr.DownloadClientResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -527,7 +458,7 @@ func (d *DownloadClient) write(ctx context.Context, downloadClient *lidarr.Downl
 	d.AdditionalTags = types.SetValueMust(types.Int64Type, nil)
 	d.FieldTags = types.SetValueMust(types.StringType, nil)
 	d.PostImportTags = types.SetValueMust(types.StringType, nil)
-	helpers.WriteFields(ctx, d, downloadClient.GetFields(), downloadClientFields)
+	d.writeFields(downloadClient.GetFields(), diags)
 }
 
 func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.DownloadClientResource {
@@ -542,7 +473,7 @@ func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *lid
 	client.SetName(d.Name.ValueString())
 	client.SetProtocol(lidarr.DownloadProtocol(d.Protocol.ValueString()))
 	diags.Append(d.Tags.ElementsAs(ctx, &client.Tags, true)...)
-	client.SetFields(helpers.ReadFields(ctx, d, downloadClientFields))
+	client.SetFields(d.readFields(ctx, diags))
 
 	return client
 }
@@ -561,3 +492,13 @@ func (d *DownloadClient) writeSensitive(client *DownloadClient) {
 		d.SecretToken = client.SecretToken
 	}
 }
+
+// downloadClientIDByName returns the ID of the download client with the given name, of the given implementation unless empty.
+func downloadClientIDByName(auth context.Context, client *lidarr.APIClient, name, implementation string) (int32, error) {
+	response, _, err := client.DownloadClientAPI.ListDownloadClient(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, downloadClientResourceName, err))
+	}
+
+	return helpers.FindImplementationIDByName(downloadClientResourceName, name, implementation, response, (*lidarr.DownloadClientResource).GetName, (*lidarr.DownloadClientResource).GetImplementation, (*lidarr.DownloadClientResource).GetId)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const downloadClientSchemaDataSourceName = "download_client_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DownloadClientSchemaDataSource{}
+
+func NewDownloadClientSchemaDataSource() datasource.DataSource {
+	return &DownloadClientSchemaDataSource{}
+}
+
+// DownloadClientSchemaDataSource defines the download client schema implementation.
+type DownloadClientSchemaDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+func (d *DownloadClientSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + downloadClientSchemaDataSourceName
+}
+
+func (d *DownloadClientSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Download Clients -->\nList the fields of each Download client implementation, as exposed by the Lidarr schema endpoint.",
+		Attributes:          implementationSchemasAttributes(),
+	}
+}
+
+func (d *DownloadClientSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *DownloadClientSchemaDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get download client schema current value
+	response, _, err := d.client.DownloadClientAPI.ListDownloadClientSchema(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, downloadClientSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+downloadClientSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	schemas := writeImplementationSchemas(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, schemas)...)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDownloadClientSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccDownloadClientSchemaDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccDownloadClientSchemaDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lidarr_download_client_schema.test", "implementations.Transmission.config_contract"),
+					resource.TestCheckResourceAttrSet("data.lidarr_download_client_schema.test", "implementations.Transmission.fields.0.name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDownloadClientSchemaDataSourceConfig = `
+data "lidarr_download_client_schema" "test" {
+}
+`
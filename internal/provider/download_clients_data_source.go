@@ -50,7 +50,7 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 				MarkdownDescription: "Download Client list..",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: withDownloadClientFieldDataSourceAttributes(map[string]schema.Attribute{
 						"enable": schema.BoolAttribute{
 							MarkdownDescription: "Enable flag.",
 							Computed:            true,
@@ -92,143 +92,7 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 							MarkdownDescription: "Download Client ID.",
 							Computed:            true,
 						},
-						// Field values
-						"add_paused": schema.BoolAttribute{
-							MarkdownDescription: "Add paused flag.",
-							Computed:            true,
-						},
-						"use_ssl": schema.BoolAttribute{
-							MarkdownDescription: "Use SSL flag.",
-							Computed:            true,
-						},
-						"start_on_add": schema.BoolAttribute{
-							MarkdownDescription: "Start on add flag.",
-							Computed:            true,
-						},
-						"sequential_order": schema.BoolAttribute{
-							MarkdownDescription: "Sequential order flag.",
-							Computed:            true,
-						},
-						"first_and_last": schema.BoolAttribute{
-							MarkdownDescription: "First and last flag.",
-							Computed:            true,
-						},
-						"add_stopped": schema.BoolAttribute{
-							MarkdownDescription: "Add stopped flag.",
-							Computed:            true,
-						},
-						"save_magnet_files": schema.BoolAttribute{
-							MarkdownDescription: "Save magnet files flag.",
-							Computed:            true,
-						},
-						"read_only": schema.BoolAttribute{
-							MarkdownDescription: "Read only flag.",
-							Computed:            true,
-						},
-						"port": schema.Int64Attribute{
-							MarkdownDescription: "Port.",
-							Computed:            true,
-						},
-						"recent_music_priority": schema.Int64Attribute{
-							MarkdownDescription: "Recent Music priority. `0` Last, `1` First.",
-							Computed:            true,
-						},
-						"older_music_priority": schema.Int64Attribute{
-							MarkdownDescription: "Older Music priority. `0` Last, `1` First.",
-							Computed:            true,
-						},
-						"initial_state": schema.Int64Attribute{
-							MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
-							Computed:            true,
-						},
-						"intial_state": schema.Int64Attribute{
-							MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
-							Computed:            true,
-						},
-						"host": schema.StringAttribute{
-							MarkdownDescription: "host.",
-							Computed:            true,
-						},
-						"api_key": schema.StringAttribute{
-							MarkdownDescription: "API key.",
-							Computed:            true,
-						},
-						"rpc_path": schema.StringAttribute{
-							MarkdownDescription: "RPC path.",
-							Computed:            true,
-						},
-						"url_base": schema.StringAttribute{
-							MarkdownDescription: "Base URL.",
-							Computed:            true,
-						},
-						"secret_token": schema.StringAttribute{
-							MarkdownDescription: "Secret token.",
-							Computed:            true,
-						},
-						"username": schema.StringAttribute{
-							MarkdownDescription: "Username.",
-							Computed:            true,
-						},
-						"password": schema.StringAttribute{
-							MarkdownDescription: "Password.",
-							Computed:            true,
-						},
-						"music_category": schema.StringAttribute{
-							MarkdownDescription: "Music category.",
-							Computed:            true,
-						},
-						"music_imported_category": schema.StringAttribute{
-							MarkdownDescription: "Music imported category.",
-							Computed:            true,
-						},
-						"music_directory": schema.StringAttribute{
-							MarkdownDescription: "Music directory.",
-							Computed:            true,
-						},
-						"destination": schema.StringAttribute{
-							MarkdownDescription: "Destination.",
-							Computed:            true,
-						},
-						"category": schema.StringAttribute{
-							MarkdownDescription: "Category.",
-							Computed:            true,
-						},
-						"watch_folder": schema.StringAttribute{
-							MarkdownDescription: "Watch folder flag.",
-							Computed:            true,
-						},
-						"nzb_folder": schema.StringAttribute{
-							MarkdownDescription: "NZB folder.",
-							Computed:            true,
-						},
-						"strm_folder": schema.StringAttribute{
-							MarkdownDescription: "STRM folder.",
-							Computed:            true,
-						},
-						"torrent_folder": schema.StringAttribute{
-							MarkdownDescription: "Torrent folder.",
-							Computed:            true,
-						},
-						"magnet_file_extension": schema.StringAttribute{
-							MarkdownDescription: "Magnet file extension.",
-							Computed:            true,
-						},
-						"additional_tags": schema.SetAttribute{
-							MarkdownDescription: "Additional tags, `0` TitleSlug, `1` Quality, `2` Language, `3` ReleaseGroup, `4` Year, `5` Indexer, `6` Network.",
-							Computed:            true,
-							ElementType:         types.Int64Type,
-						},
-						"field_tags": schema.SetAttribute{
-							MarkdownDescription: "Field tags.",
-							Computed:            true,
-							ElementType:         types.StringType,
-						},
-						"post_import_tags": schema.SetAttribute{
-							MarkdownDescription: "Post import tags.",
-							Computed:            true,
-							ElementType:         types.StringType,
-						},
-					},
+					}),
 				},
 			},
 		},
@@ -0,0 +1,602 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var exportNameRegexp = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportedTypes maps the resource types checked by the exporters to the exported resource type,
+// which is the generic one for implementation specific resources.
+var exportedTypes = map[string]string{
+	"lidarr_tag":                                      "lidarr_tag",
+	"lidarr_custom_format":                            "lidarr_custom_format",
+	"lidarr_quality_profile":                          "lidarr_quality_profile",
+	"lidarr_metadata_profile":                         "lidarr_metadata_profile",
+	"lidarr_root_folder":                              "lidarr_root_folder",
+	"lidarr_indexer":                                  "lidarr_indexer",
+	"lidarr_indexer_filelist":                         "lidarr_indexer",
+	"lidarr_indexer_gazelle":                          "lidarr_indexer",
+	"lidarr_indexer_headphones":                       "lidarr_indexer",
+	"lidarr_indexer_iptorrents":                       "lidarr_indexer",
+	"lidarr_indexer_newznab":                          "lidarr_indexer",
+	"lidarr_indexer_nyaa":                             "lidarr_indexer",
+	"lidarr_indexer_redacted":                         "lidarr_indexer",
+	"lidarr_indexer_torrent_rss":                      "lidarr_indexer",
+	"lidarr_indexer_torrentleech":                     "lidarr_indexer",
+	"lidarr_indexer_torznab":                          "lidarr_indexer",
+	"lidarr_download_client":                          "lidarr_download_client",
+	"lidarr_download_client_aria2":                    "lidarr_download_client",
+	"lidarr_download_client_deluge":                   "lidarr_download_client",
+	"lidarr_download_client_flood":                    "lidarr_download_client",
+	"lidarr_download_client_hadouken":                 "lidarr_download_client",
+	"lidarr_download_client_nzbget":                   "lidarr_download_client",
+	"lidarr_download_client_nzbvortex":                "lidarr_download_client",
+	"lidarr_download_client_pneumatic":                "lidarr_download_client",
+	"lidarr_download_client_qbittorrent":              "lidarr_download_client",
+	"lidarr_download_client_rtorrent":                 "lidarr_download_client",
+	"lidarr_download_client_sabnzbd":                  "lidarr_download_client",
+	"lidarr_download_client_torrent_blackhole":        "lidarr_download_client",
+	"lidarr_download_client_torrent_download_station": "lidarr_download_client",
+	"lidarr_download_client_transmission":             "lidarr_download_client",
+	"lidarr_download_client_usenet_blackhole":         "lidarr_download_client",
+	"lidarr_download_client_usenet_download_station":  "lidarr_download_client",
+	"lidarr_download_client_utorrent":                 "lidarr_download_client",
+	"lidarr_download_client_vuze":                     "lidarr_download_client",
+	"lidarr_notification":                             "lidarr_notification",
+	"lidarr_notification_apprise":                     "lidarr_notification",
+	"lidarr_notification_custom_script":               "lidarr_notification",
+	"lidarr_notification_discord":                     "lidarr_notification",
+	"lidarr_notification_email":                       "lidarr_notification",
+	"lidarr_notification_emby":                        "lidarr_notification",
+	"lidarr_notification_gotify":                      "lidarr_notification",
+	"lidarr_notification_join":                        "lidarr_notification",
+	"lidarr_notification_kodi":                        "lidarr_notification",
+	"lidarr_notification_mailgun":                     "lidarr_notification",
+	"lidarr_notification_notifiarr":                   "lidarr_notification",
+	"lidarr_notification_ntfy":                        "lidarr_notification",
+	"lidarr_notification_plex":                        "lidarr_notification",
+	"lidarr_notification_prowl":                       "lidarr_notification",
+	"lidarr_notification_pushbullet":                  "lidarr_notification",
+	"lidarr_notification_pushover":                    "lidarr_notification",
+	"lidarr_notification_sendgrid":                    "lidarr_notification",
+	"lidarr_notification_signal":                      "lidarr_notification",
+	"lidarr_notification_simplepush":                  "lidarr_notification",
+	"lidarr_notification_slack":                       "lidarr_notification",
+	"lidarr_notification_subsonic":                    "lidarr_notification",
+	"lidarr_notification_synology_indexer":            "lidarr_notification",
+	"lidarr_notification_telegram":                    "lidarr_notification",
+	"lidarr_notification_twitter":                     "lidarr_notification",
+	"lidarr_notification_webhook":                     "lidarr_notification",
+	"lidarr_import_list":                              "lidarr_import_list",
+	"lidarr_import_list_headphones":                   "lidarr_import_list",
+	"lidarr_import_list_lastfm_tag":                   "lidarr_import_list",
+	"lidarr_import_list_lastfm_user":                  "lidarr_import_list",
+	"lidarr_import_list_lidarr":                       "lidarr_import_list",
+	"lidarr_import_list_lidarr_list":                  "lidarr_import_list",
+	"lidarr_import_list_music_brainz":                 "lidarr_import_list",
+	"lidarr_import_list_spotify_albums":               "lidarr_import_list",
+	"lidarr_import_list_spotify_artists":              "lidarr_import_list",
+	"lidarr_import_list_spotify_playlists":            "lidarr_import_list",
+	"lidarr_artist":                                   "lidarr_artist",
+}
+
+// ExportedResource describes a Lidarr object as a Terraform resource configuration.
+type ExportedResource struct {
+	// Attributes contains the configurable attributes, sensitive ones are omitted.
+	Attributes map[string]cty.Value
+	// Values contains the configurable attribute values as encoded in state, sensitive ones are omitted.
+	Values map[string]any
+	// Type is the resource type name (e.g. `lidarr_tag`).
+	Type string
+	// Name is the resource name, unique per type.
+	Name string
+	// ID is the import identifier.
+	ID string
+}
+
+// exportItem is a resource model to be exported.
+type exportItem struct {
+	model any
+	name  string
+	id    int32
+}
+
+// exporter lists all the objects of a resource type.
+type exporter struct {
+	resource func() resource.Resource
+	list     func(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem
+}
+
+// exporters returns the supported exporters, in dependency order.
+func exporters() []exporter {
+	return []exporter{
+		{NewTagResource, exportTags},
+		{NewCustomFormatResource, exportCustomFormats},
+		{NewQualityProfileResource, exportQualityProfiles},
+		{NewMetadataProfileResource, exportMetadataProfiles},
+		{NewRootFolderResource, exportRootFolders},
+		{NewIndexerResource, exportIndexers},
+		{NewDownloadClientResource, exportDownloadClients},
+		{NewNotificationResource, exportNotifications},
+		{NewImportListResource, exportImportLists},
+		{NewArtistResource, exportArtists},
+	}
+}
+
+// Export reads the Lidarr configuration and maps each object to its resource configuration.
+func Export(ctx context.Context, client *lidarr.APIClient, auth context.Context) ([]ExportedResource, diag.Diagnostics) {
+	var (
+		output []ExportedResource
+		diags  diag.Diagnostics
+	)
+
+	for _, e := range exporters() {
+		r := e.resource()
+
+		metadataResp := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "lidarr"}, &metadataResp)
+
+		schemaResp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		names := make(map[string]int)
+
+		for _, item := range e.list(ctx, client, auth, &diags) {
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+
+			diags.Append(state.Set(ctx, item.model)...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			var values map[string]tftypes.Value
+			if err := state.Raw.As(&values); err != nil {
+				diags.AddError(helpers.ResourceError, fmt.Sprintf("Unable to export %s: %s", metadataResp.TypeName, err))
+
+				return nil, diags
+			}
+
+			output = append(output, ExportedResource{
+				Attributes: exportAttributes(schemaResp.Schema.Attributes, values),
+				Values:     exportValues(schemaResp.Schema.Attributes, values),
+				Type:       metadataResp.TypeName,
+				Name:       exportName(item.name, names),
+				ID:         strconv.Itoa(int(item.id)),
+			})
+		}
+	}
+
+	return output, diags
+}
+
+// ExportedType returns the exported resource type covering the given resource type,
+// either the same type or the generic one for implementation specific resources.
+func ExportedType(resourceType string) (string, bool) {
+	exportedType, ok := exportedTypes[resourceType]
+
+	return exportedType, ok
+}
+
+// exportName returns a valid and unique resource name.
+func exportName(name string, names map[string]int) string {
+	name = strings.Trim(exportNameRegexp.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	names[name]++
+	if names[name] > 1 {
+		name = fmt.Sprintf("%s_%d", name, names[name])
+	}
+
+	return name
+}
+
+// exportAttributes converts the configurable attribute values.
+func exportAttributes(attributes map[string]schema.Attribute, values map[string]tftypes.Value) map[string]cty.Value {
+	output := make(map[string]cty.Value)
+
+	for name, attribute := range attributes {
+		// Computed only and sensitive attributes cannot be exported.
+		if (!attribute.IsOptional() && !attribute.IsRequired()) || attribute.IsSensitive() {
+			continue
+		}
+
+		value, ok := values[name]
+		if !ok || value.IsNull() || !value.IsKnown() {
+			continue
+		}
+
+		converted := exportValue(attribute, value)
+		// Empty computed values are left to the provider.
+		if attribute.IsComputed() && isEmptyValue(converted) {
+			continue
+		}
+
+		output[name] = converted
+	}
+
+	return output
+}
+
+// exportValues converts the non sensitive attribute values.
+// Computed only attributes are omitted, since Lidarr is free to change them.
+func exportValues(attributes map[string]schema.Attribute, values map[string]tftypes.Value) map[string]any {
+	output := make(map[string]any, len(values))
+
+	for name, attribute := range attributes {
+		if !attribute.IsSensitive() && (attribute.IsRequired() || attribute.IsOptional()) {
+			output[name] = toAny(values[name])
+		}
+	}
+
+	return output
+}
+
+// toAny converts a terraform value into its JSON representation.
+func toAny(value tftypes.Value) any {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var v string
+
+		_ = value.As(&v)
+
+		return v
+	case value.Type().Is(tftypes.Number):
+		v := new(big.Float)
+
+		_ = value.As(&v)
+		f, _ := v.Float64()
+
+		return f
+	case value.Type().Is(tftypes.Bool):
+		var v bool
+
+		_ = value.As(&v)
+
+		return v
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		_ = value.As(&elements)
+		output := make([]any, len(elements))
+
+		for i, element := range elements {
+			output[i] = toAny(element)
+		}
+
+		return output
+	default:
+		var elements map[string]tftypes.Value
+
+		_ = value.As(&elements)
+		output := make(map[string]any, len(elements))
+
+		for name, element := range elements {
+			output[name] = toAny(element)
+		}
+
+		return output
+	}
+}
+
+// exportValue converts a value, following the nested attributes schema if any.
+func exportValue(attribute schema.Attribute, value tftypes.Value) cty.Value {
+	var nested map[string]schema.Attribute
+
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		var values map[string]tftypes.Value
+
+		_ = value.As(&values)
+
+		return cty.ObjectVal(exportAttributes(a.Attributes, values))
+	case schema.ListNestedAttribute:
+		nested = a.NestedObject.Attributes
+	case schema.SetNestedAttribute:
+		nested = a.NestedObject.Attributes
+	default:
+		return toCty(value)
+	}
+
+	var elements []tftypes.Value
+
+	_ = value.As(&elements)
+	output := make([]cty.Value, len(elements))
+
+	for i, element := range elements {
+		var values map[string]tftypes.Value
+
+		_ = element.As(&values)
+		output[i] = cty.ObjectVal(exportAttributes(nested, values))
+	}
+
+	return tupleVal(output)
+}
+
+// toCty converts a terraform value into a cty value.
+func toCty(value tftypes.Value) cty.Value {
+	if value.IsNull() || !value.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var v string
+
+		_ = value.As(&v)
+
+		return cty.StringVal(v)
+	case value.Type().Is(tftypes.Number):
+		v := new(big.Float)
+
+		_ = value.As(&v)
+
+		return cty.NumberVal(v)
+	case value.Type().Is(tftypes.Bool):
+		var v bool
+
+		_ = value.As(&v)
+
+		return cty.BoolVal(v)
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		_ = value.As(&elements)
+		output := make([]cty.Value, len(elements))
+
+		for i, element := range elements {
+			output[i] = toCty(element)
+		}
+
+		return tupleVal(output)
+	default:
+		var elements map[string]tftypes.Value
+
+		_ = value.As(&elements)
+		output := make(map[string]cty.Value, len(elements))
+
+		for name, element := range elements {
+			if !element.IsNull() {
+				output[name] = toCty(element)
+			}
+		}
+
+		return cty.ObjectVal(output)
+	}
+}
+
+// tupleVal returns a sorted tuple, to have a stable output for sets.
+func tupleVal(values []cty.Value) cty.Value {
+	if len(values) == 0 {
+		return cty.EmptyTupleVal
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].GoString() < values[j].GoString()
+	})
+
+	return cty.TupleVal(values)
+}
+
+func isEmptyValue(value cty.Value) bool {
+	switch {
+	case value.Type() == cty.String:
+		return value.AsString() == ""
+	case value.Type().IsTupleType(), value.Type().IsObjectType():
+		return value.LengthInt() == 0
+	default:
+		return false
+	}
+}
+
+func exportTags(_ context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.TagAPI.ListTag(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, len(response))
+	for i, t := range response {
+		tag := Tag{}
+		tag.write(&t)
+		output[i] = exportItem{model: &tag, name: t.GetLabel(), id: t.GetId()}
+	}
+
+	return output
+}
+
+func exportCustomFormats(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.CustomFormatAPI.ListCustomFormat(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, customFormatResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, len(response))
+	for i, c := range response {
+		customFormat := CustomFormat{}
+		customFormat.write(ctx, &c, diags)
+		output[i] = exportItem{model: &customFormat, name: c.GetName(), id: c.GetId()}
+	}
+
+	return output
+}
+
+func exportQualityProfiles(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.QualityProfileAPI.ListQualityProfile(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, qualityProfileResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, len(response))
+	for i, p := range response {
+		profile := QualityProfile{}
+		profile.write(ctx, &p, diags)
+		output[i] = exportItem{model: &profile, name: p.GetName(), id: p.GetId()}
+	}
+
+	return output
+}
+
+func exportMetadataProfiles(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.MetadataProfileAPI.ListMetadataProfile(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, metadataProfileResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, 0, len(response))
+
+	for _, p := range response {
+		// Skip the built-in profile which cannot be managed.
+		if p.GetName() == "None" {
+			continue
+		}
+
+		profile := MetadataProfile{}
+		profile.write(ctx, &p, diags)
+		output = append(output, exportItem{model: &profile, name: p.GetName(), id: p.GetId()})
+	}
+
+	return output
+}
+
+func exportRootFolders(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.RootFolderAPI.ListRootFolder(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, rootFolderResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, len(response))
+	for i, f := range response {
+		folder := RootFolder{}
+		folder.write(ctx, &f, diags)
+		output[i] = exportItem{model: &folder, name: f.GetName(), id: f.GetId()}
+	}
+
+	return output
+}
+
+func exportIndexers(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.IndexerAPI.ListIndexer(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, indexerResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, len(response))
+	for i, x := range response {
+		indexer := indexerResourceModel{Fields: types.MapNull(types.StringType), SensitiveFields: types.MapNull(types.StringType)}
+		indexer.write(ctx, &x, diags)
+		output[i] = exportItem{model: &indexer, name: x.GetName(), id: x.GetId()}
+	}
+
+	return output
+}
+
+func exportDownloadClients(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.DownloadClientAPI.ListDownloadClient(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, downloadClientResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, len(response))
+	for i, d := range response {
+		downloadClient := downloadClientResourceModel{Fields: types.MapNull(types.StringType), SensitiveFields: types.MapNull(types.StringType)}
+		downloadClient.write(ctx, &d, diags)
+		output[i] = exportItem{model: &downloadClient, name: d.GetName(), id: d.GetId()}
+	}
+
+	return output
+}
+
+func exportNotifications(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.NotificationAPI.ListNotification(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, notificationResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, len(response))
+	for i, n := range response {
+		notification := notificationResourceModel{Fields: types.MapNull(types.StringType), SensitiveFields: types.MapNull(types.StringType)}
+		notification.write(ctx, &n, diags)
+		output[i] = exportItem{model: &notification, name: n.GetName(), id: n.GetId()}
+	}
+
+	return output
+}
+
+func exportImportLists(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.ImportListAPI.ListImportList(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, importListResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, len(response))
+	for i, l := range response {
+		importList := importListResourceModel{Fields: types.MapNull(types.StringType), SensitiveFields: types.MapNull(types.StringType)}
+		importList.write(ctx, &l, diags)
+		output[i] = exportItem{model: &importList, name: l.GetName(), id: l.GetId()}
+	}
+
+	return output
+}
+
+func exportArtists(ctx context.Context, client *lidarr.APIClient, auth context.Context, diags *diag.Diagnostics) []exportItem {
+	response, _, err := client.ArtistAPI.ListArtist(auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, artistResourceName, err))
+
+		return nil
+	}
+
+	output := make([]exportItem, len(response))
+	for i, a := range response {
+		artist := ArtistWithAddOptions{AddOptions: types.ObjectNull(ArtistAddOptions{}.getType().(types.ObjectType).AttrTypes)}
+		artist.write(ctx, &a, diags)
+		output[i] = exportItem{model: &artist, name: a.GetArtistName(), id: a.GetId()}
+	}
+
+	return output
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportedTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	typeName := func(r resource.Resource) string {
+		resp := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "lidarr"}, &resp)
+
+		return resp.TypeName
+	}
+
+	resources := make(map[string]bool)
+	for _, r := range New("test")().Resources(ctx) {
+		resources[typeName(r())] = true
+	}
+
+	exported := make(map[string]bool)
+	for _, e := range exporters() {
+		exported[typeName(e.resource())] = true
+	}
+
+	for resourceType, exportedType := range exportedTypes {
+		assert.True(t, resources[resourceType], resourceType)
+		assert.True(t, exported[exportedType], resourceType)
+	}
+
+	for exportedType := range exported {
+		assert.Equal(t, exportedType, exportedTypes[exportedType])
+	}
+}
+
+func TestExportedType(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resourceType string
+		expected     string
+		ok           bool
+	}{
+		"generic":        {resourceType: "lidarr_indexer", expected: "lidarr_indexer", ok: true},
+		"implementation": {resourceType: "lidarr_indexer_newznab", expected: "lidarr_indexer", ok: true},
+		"config":         {resourceType: "lidarr_indexer_config", expected: "", ok: false},
+		"exclusion":      {resourceType: "lidarr_import_list_exclusion", expected: "", ok: false},
+		"not exported":   {resourceType: "lidarr_delay_profile", expected: "", ok: false},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			exportedType, ok := ExportedType(test.resourceType)
+			assert.Equal(t, test.expected, exportedType)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// implementationSchema is implemented by the resources returned by the schema endpoints.
+type implementationSchema interface {
+	GetImplementation() string
+	GetFields() []lidarr.Field
+}
+
+// fieldsAttribute returns the schema of the free-form fields of a generic resource.
+func fieldsAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Free-form fields not covered by a dedicated attribute, keyed by API field name. " +
+			"Names and values are validated against the implementation schema at plan time. " +
+			"Lists are expressed in JSON format, e.g. `jsonencode([1, 2])`. Secret fields must be set in `sensitive_fields`.",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+}
+
+// sensitiveFieldsAttribute returns the schema of the free-form secret fields of a generic resource.
+func sensitiveFieldsAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Free-form fields like `fields`, with values hidden from the plan output. Secret fields, such as passwords and API keys, must be set here.",
+		Optional:            true,
+		Sensitive:           true,
+		ElementType:         types.StringType,
+	}
+}
+
+// schemaFields returns the schema fields of the given implementation.
+func schemaFields[T any, P interface {
+	*T
+	implementationSchema
+}](schemas []T, implementation string) ([]lidarr.Field, bool) {
+	for i := range schemas {
+		if P(&schemas[i]).GetImplementation() == implementation {
+			return P(&schemas[i]).GetFields(), true
+		}
+	}
+
+	return nil, false
+}
+
+// validateImplementation adds an error if the implementation is not part of the schema.
+func validateImplementation(found bool, implementation string, diags *diag.Diagnostics) {
+	if !found {
+		diags.AddAttributeError(path.Root("implementation"), helpers.FieldError,
+			fmt.Sprintf("Implementation '%s' is not supported by this Lidarr instance.", implementation))
+	}
+}
+
+// readFreeFields validates the free-form fields against the implementation schema and returns their API representation.
+// Fields already managed by an attribute are rejected, as well as secret fields not set in the sensitive map.
+func readFreeFields(ctx context.Context, fields, sensitiveFields types.Map, schemaFields []lidarr.Field, managed map[string]string, diags *diag.Diagnostics) []lidarr.Field {
+	output := readFreeFieldMap(ctx, path.Root("fields"), fields, schemaFields, managed, false, diags)
+
+	for _, field := range readFreeFieldMap(ctx, path.Root("sensitive_fields"), sensitiveFields, schemaFields, managed, true, diags) {
+		if findField(output, field.GetName()) != nil {
+			diags.AddAttributeError(path.Root("sensitive_fields").AtMapKey(field.GetName()), helpers.FieldError,
+				fmt.Sprintf("Field '%s' is already set in fields.", field.GetName()))
+
+			continue
+		}
+
+		output = append(output, field)
+	}
+
+	return output
+}
+
+// readFreeFieldMap validates a free-form fields map and returns its API representation.
+func readFreeFieldMap(ctx context.Context, root path.Path, fields types.Map, schemaFields []lidarr.Field, managed map[string]string, sensitive bool, diags *diag.Diagnostics) []lidarr.Field {
+	if fields.IsNull() || fields.IsUnknown() {
+		return nil
+	}
+
+	values := make(map[string]types.String, len(fields.Elements()))
+	diags.Append(fields.ElementsAs(ctx, &values, true)...)
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var output []lidarr.Field
+
+	for _, name := range names {
+		fieldPath := root.AtMapKey(name)
+
+		if attribute, ok := managed[name]; ok {
+			diags.AddAttributeError(fieldPath, helpers.FieldError, fmt.Sprintf("Field '%s' is managed by attribute '%s', use it instead.", name, attribute))
+
+			continue
+		}
+
+		field := findField(schemaFields, name)
+		if field == nil {
+			diags.AddAttributeError(fieldPath, helpers.FieldError, fmt.Sprintf("Field '%s' is not defined by the implementation schema.", name))
+
+			continue
+		}
+
+		if !sensitive && isSecretField(field) {
+			diags.AddAttributeError(fieldPath, helpers.FieldError, fmt.Sprintf("Field '%s' is a secret, set it in sensitive_fields instead.", name))
+
+			continue
+		}
+
+		if values[name].IsUnknown() || values[name].IsNull() {
+			continue
+		}
+
+		value, err := helpers.SchemaFieldValue(field, values[name].ValueString())
+		if err != nil {
+			diags.AddAttributeError(fieldPath, helpers.FieldError, helpers.ParseFieldError(name, err))
+
+			continue
+		}
+
+		apiField := lidarr.NewField()
+		apiField.SetName(name)
+		apiField.SetValue(value)
+		output = append(output, *apiField)
+	}
+
+	return output
+}
+
+// mergeFields adds the free-form fields to the API fields, replacing the ones with the same name.
+func mergeFields(fields, freeFields []lidarr.Field) []lidarr.Field {
+	for _, free := range freeFields {
+		if field := findField(fields, free.GetName()); field != nil {
+			*field = free
+
+			continue
+		}
+
+		fields = append(fields, free)
+	}
+
+	return fields
+}
+
+// writeFreeFields returns the free-form fields updated with the API values.
+// Only the fields already present are tracked, values equivalent to the current ones or masked are kept as they are.
+func writeFreeFields(ctx context.Context, current types.Map, fields []lidarr.Field, diags *diag.Diagnostics) types.Map {
+	if current.IsNull() || current.IsUnknown() {
+		return types.MapNull(types.StringType)
+	}
+
+	values := make(map[string]types.String, len(current.Elements()))
+	diags.Append(current.ElementsAs(ctx, &values, true)...)
+
+	output := make(map[string]attr.Value, len(values))
+
+	for name, value := range values {
+		output[name] = value
+
+		field := findField(fields, name)
+		if value.IsNull() || field == nil || field.GetValue() == helpers.SensitiveValue {
+			continue
+		}
+
+		if value.IsUnknown() || !helpers.SameFieldValue(value.ValueString(), field.GetValue()) {
+			output[name] = types.StringValue(helpers.FieldValueString(field.GetValue()))
+		}
+	}
+
+	result, localDiag := types.MapValue(types.StringType, output)
+	diags.Append(localDiag...)
+
+	return result
+}
+
+// hasFreeFields identifies at least one known free-form fields map with at least one element.
+func hasFreeFields(fields ...types.Map) bool {
+	for _, f := range fields {
+		if !f.IsNull() && !f.IsUnknown() && len(f.Elements()) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSecretField identifies the schema fields masked by the API.
+func isSecretField(field *lidarr.Field) bool {
+	privacy := field.GetPrivacy()
+
+	return privacy == lidarr.PRIVACYLEVEL_PASSWORD || privacy == lidarr.PRIVACYLEVEL_API_KEY
+}
+
+func findField(fields []lidarr.Field, name string) *lidarr.Field {
+	for i := range fields {
+		if fields[i].GetName() == name {
+			return &fields[i]
+		}
+	}
+
+	return nil
+}
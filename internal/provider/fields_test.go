@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// fieldMapper is implemented by the models with generated field mappings.
+type fieldMapper interface {
+	readFields(context.Context, *diag.Diagnostics) []lidarr.Field
+	writeFields([]lidarr.Field, *diag.Diagnostics)
+}
+
+// fieldSpec is the subset of the field spec used by the tests.
+type fieldSpec struct {
+	Model      string `json:"model"`
+	Attributes []struct {
+		Attribute string   `json:"attribute"`
+		Type      string   `json:"type"`
+		Fields    []string `json:"fields"`
+	} `json:"attributes"`
+}
+
+// TestFieldsRoundTrip checks that every attribute of the field specs
+// survives a round trip through the API representation.
+func TestFieldsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	models := map[string]func() fieldMapper{
+		"CustomFormatCondition": func() fieldMapper { return &CustomFormatCondition{} },
+		"DownloadClient":        func() fieldMapper { return &DownloadClient{} },
+		"ImportList":            func() fieldMapper { return &ImportList{} },
+		"Indexer":               func() fieldMapper { return &Indexer{} },
+		"Metadata":              func() fieldMapper { return &Metadata{} },
+		"Notification":          func() fieldMapper { return &Notification{} },
+	}
+
+	specs, err := filepath.Glob("fieldspecs/*.json")
+	assert.NoError(t, err)
+	assert.Len(t, specs, len(models))
+
+	for _, path := range specs {
+		path := path
+
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			t.Parallel()
+
+			content, err := os.ReadFile(path)
+			assert.NoError(t, err)
+
+			var spec fieldSpec
+
+			assert.NoError(t, json.Unmarshal(content, &spec))
+			assert.Contains(t, models, spec.Model)
+
+			var diags diag.Diagnostics
+
+			container := models[spec.Model]()
+			fillFields(t, container, spec)
+
+			fields := container.readFields(context.Background(), &diags)
+			assert.False(t, diags.HasError(), diags)
+
+			// Every API field name is sent.
+			names := make([]string, len(fields))
+			for i, f := range fields {
+				names[i] = f.GetName()
+			}
+
+			for _, a := range spec.Attributes {
+				assert.Subset(t, names, a.Fields)
+			}
+
+			// Simulate the API serialization.
+			data, err := json.Marshal(fields)
+			assert.NoError(t, err)
+
+			var response []lidarr.Field
+			assert.NoError(t, json.Unmarshal(data, &response))
+
+			written := models[spec.Model]()
+			written.writeFields(response, &diags)
+			assert.False(t, diags.HasError(), diags)
+			assert.Equal(t, container, written)
+		})
+	}
+}
+
+// fillFields sets a distinct value for each attribute of the spec.
+func fillFields(t *testing.T, container fieldMapper, spec fieldSpec) {
+	t.Helper()
+
+	values := map[string]func(string) attr.Value{
+		"bool":    func(string) attr.Value { return types.BoolValue(true) },
+		"int64":   func(name string) attr.Value { return types.Int64Value(int64(len(name))) },
+		"float64": func(name string) attr.Value { return types.Float64Value(float64(len(name)) + 0.5) },
+		"string":  func(name string) attr.Value { return types.StringValue("value-" + name) },
+		"string_set": func(name string) attr.Value {
+			return types.SetValueMust(types.StringType, []attr.Value{types.StringValue(name), types.StringValue("value")})
+		},
+		"int64_set": func(name string) attr.Value {
+			return types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(int64(len(name))), types.Int64Value(1)})
+		},
+	}
+
+	model := reflect.ValueOf(container).Elem()
+
+	for _, a := range spec.Attributes {
+		field := model.FieldByNameFunc(func(n string) bool {
+			f, _ := model.Type().FieldByName(n)
+
+			return f.Tag.Get("tfsdk") == a.Attribute
+		})
+		if !field.IsValid() {
+			t.Errorf("no field of %s has attribute '%s'", spec.Model, a.Attribute)
+
+			continue
+		}
+
+		field.Set(reflect.ValueOf(values[a.Type](a.Attribute)))
+	}
+}
+
+func TestReadFreeFields(t *testing.T) {
+	t.Parallel()
+
+	schema := []lidarr.Field{
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("reject")), Type: *lidarr.NewNullableString(lidarr.PtrString("checkbox"))},
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("limit")), Type: *lidarr.NewNullableString(lidarr.PtrString("number"))},
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("apiKey")), Type: *lidarr.NewNullableString(lidarr.PtrString("textbox"))},
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("token")), Type: *lidarr.NewNullableString(lidarr.PtrString("password")), Privacy: lidarr.PRIVACYLEVEL_PASSWORD.Ptr()},
+	}
+	managed := map[string]string{"apiKey": "api_key"}
+
+	tests := map[string]struct {
+		values    map[string]attr.Value
+		sensitive map[string]attr.Value
+		expected  map[string]interface{}
+		errors    []path.Path
+	}{
+		"valid": {
+			values:   map[string]attr.Value{"reject": types.StringValue("true"), "limit": types.StringValue("5")},
+			expected: map[string]interface{}{"reject": true, "limit": int64(5)},
+		},
+		"sensitive": {
+			values:    map[string]attr.Value{"limit": types.StringValue("5")},
+			sensitive: map[string]attr.Value{"token": types.StringValue("secret")},
+			expected:  map[string]interface{}{"limit": int64(5), "token": "secret"},
+		},
+		"secret not sensitive": {
+			values:   map[string]attr.Value{"token": types.StringValue("secret")},
+			expected: map[string]interface{}{},
+			errors:   []path.Path{path.Root("fields").AtMapKey("token")},
+		},
+		"duplicate": {
+			values:    map[string]attr.Value{"limit": types.StringValue("5")},
+			sensitive: map[string]attr.Value{"limit": types.StringValue("6"), "apiKey": types.StringValue("key")},
+			expected:  map[string]interface{}{"limit": int64(5)},
+			errors: []path.Path{
+				path.Root("sensitive_fields").AtMapKey("apiKey"),
+				path.Root("sensitive_fields").AtMapKey("limit"),
+			},
+		},
+		"unknown value": {
+			values:   map[string]attr.Value{"reject": types.StringUnknown()},
+			expected: map[string]interface{}{},
+		},
+		"invalid": {
+			values: map[string]attr.Value{
+				"reject":  types.StringValue("maybe"),
+				"apiKey":  types.StringValue("key"),
+				"missing": types.StringValue("value"),
+			},
+			expected: map[string]interface{}{},
+			errors: []path.Path{
+				path.Root("fields").AtMapKey("apiKey"),
+				path.Root("fields").AtMapKey("missing"),
+				path.Root("fields").AtMapKey("reject"),
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			sensitive := types.MapNull(types.StringType)
+			if test.sensitive != nil {
+				sensitive = types.MapValueMust(types.StringType, test.sensitive)
+			}
+
+			fields := readFreeFields(context.Background(), types.MapValueMust(types.StringType, test.values), sensitive, schema, managed, &diags)
+
+			values := make(map[string]interface{}, len(fields))
+			for _, f := range fields {
+				values[f.GetName()] = f.GetValue()
+			}
+
+			assert.Equal(t, test.expected, values)
+
+			paths := make([]path.Path, 0, len(diags))
+			for _, d := range diags {
+				paths = append(paths, d.(diag.DiagnosticWithPath).Path())
+			}
+
+			assert.ElementsMatch(t, test.errors, paths)
+		})
+	}
+}
+
+func TestWriteFreeFields(t *testing.T) {
+	t.Parallel()
+
+	fields := []lidarr.Field{
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("reject")), Value: true},
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("ratio")), Value: 1.5},
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("secret")), Value: "********"},
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("ignored")), Value: "value"},
+	}
+
+	tests := map[string]struct {
+		current  types.Map
+		expected types.Map
+	}{
+		"null": {
+			current:  types.MapNull(types.StringType),
+			expected: types.MapNull(types.StringType),
+		},
+		"equivalent": {
+			current: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"ratio":   types.StringValue("1.50"),
+				"secret":  types.StringValue("password"),
+				"missing": types.StringValue("value"),
+			}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"ratio":   types.StringValue("1.50"),
+				"secret":  types.StringValue("password"),
+				"missing": types.StringValue("value"),
+			}),
+		},
+		"drift": {
+			current: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"reject": types.StringValue("false"),
+				"ratio":  types.StringUnknown(),
+			}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"reject": types.StringValue("true"),
+				"ratio":  types.StringValue("1.5"),
+			}),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			assert.Equal(t, test.expected, writeFreeFields(context.Background(), test.current, fields, &diags))
+			assert.False(t, diags.HasError())
+		})
+	}
+}
+
+func TestMergeFields(t *testing.T) {
+	t.Parallel()
+
+	fields := []lidarr.Field{{Name: *lidarr.NewNullableString(lidarr.PtrString("a")), Value: "old"}}
+	free := []lidarr.Field{
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("a")), Value: "new"},
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("b")), Value: true},
+	}
+
+	assert.Equal(t, free, mergeFields(fields, free))
+}
+
+// TestFieldAttributesSensitive checks that the secrets masked by the API are sensitive in every schema.
+func TestFieldAttributesSensitive(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resource   map[string]schema.Attribute
+		dataSource map[string]dataSourceSchema.Attribute
+		secrets    []string
+	}{
+		"download_client": {
+			resource:   withDownloadClientFieldAttributes(map[string]schema.Attribute{}),
+			dataSource: withDownloadClientFieldDataSourceAttributes(map[string]dataSourceSchema.Attribute{}),
+			secrets:    []string{"api_key", "password", "secret_token"},
+		},
+		"import_list": {
+			resource:   withImportListFieldAttributes(map[string]schema.Attribute{}),
+			dataSource: withImportListFieldDataSourceAttributes(map[string]dataSourceSchema.Attribute{}),
+			secrets:    []string{"access_token", "api_key", "refresh_token"},
+		},
+		"indexer": {
+			resource:   withIndexerFieldAttributes(map[string]schema.Attribute{}),
+			dataSource: withIndexerFieldDataSourceAttributes(map[string]dataSourceSchema.Attribute{}),
+			secrets:    []string{"api_key", "passkey", "password"},
+		},
+		"notification": {
+			resource:   withNotificationFieldAttributes(map[string]schema.Attribute{}),
+			dataSource: withNotificationFieldDataSourceAttributes(map[string]dataSourceSchema.Attribute{}),
+			secrets: []string{
+				"access_token", "access_token_secret", "api_key", "app_token", "auth_password", "auth_token", "bot_token",
+				"configuration_key", "consumer_key", "consumer_secret", "key", "password", "sender_number", "user_key",
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, secret := range test.secrets {
+				assert.True(t, test.resource[secret].IsSensitive(), "resource attribute %s", secret)
+				assert.True(t, test.dataSource[secret].IsSensitive(), "data source attribute %s", secret)
+			}
+		})
+	}
+}
+
+// TestReadFieldsUnsetSecrets checks that unset secrets are not sent on creation,
+// since the API would store the masked value as the secret.
+func TestReadFieldsUnsetSecrets(t *testing.T) {
+	t.Parallel()
+
+	models := map[string]fieldMapper{
+		"DownloadClient": &DownloadClient{},
+		"ImportList":     &ImportList{},
+		"Indexer":        &Indexer{},
+		"Notification":   &Notification{},
+	}
+	for name, model := range models {
+		model := model
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			for _, f := range model.readFields(context.Background(), &diags) {
+				assert.NotEqual(t, helpers.SensitiveValue, f.GetValue(), f.GetName())
+			}
+
+			assert.False(t, diags.HasError())
+		})
+	}
+}
+
+func TestMaskFields(t *testing.T) {
+	t.Parallel()
+
+	plan := Indexer{
+		APIKey:   types.StringUnknown(),
+		Password: types.StringValue("new"),
+		Passkey:  types.StringNull(),
+	}
+	prior := Indexer{
+		APIKey:   types.StringValue("key"),
+		Password: types.StringValue("old"),
+		Passkey:  types.StringNull(),
+	}
+
+	fields := plan.maskFields(nil, &prior)
+	assert.Len(t, fields, 1)
+	assert.Equal(t, "apiKey", fields[0].GetName())
+	assert.Equal(t, helpers.SensitiveValue, fields[0].GetValue())
+}
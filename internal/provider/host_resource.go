@@ -414,7 +414,7 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Create new Host
 	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, hostResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, hostResourceName, err, validationAttribute(hostResourceName))
 
 		return
 	}
@@ -465,7 +465,7 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update Host
 	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, hostResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, hostResourceName, err, validationAttribute(hostResourceName))
 
 		return
 	}
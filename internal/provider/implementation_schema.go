@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// implementationSchemaDetails is implemented by the resources returned by the schema endpoints.
+type implementationSchemaDetails interface {
+	implementationSchema
+	GetImplementationName() string
+	GetConfigContract() string
+}
+
+// ImplementationSchemas describes the implementation schemas data model.
+type ImplementationSchemas struct {
+	Implementations types.Map    `tfsdk:"implementations"`
+	ID              types.String `tfsdk:"id"`
+}
+
+// ImplementationSchema describes the implementation schema data model.
+type ImplementationSchema struct {
+	Fields             types.List   `tfsdk:"fields"`
+	ImplementationName types.String `tfsdk:"implementation_name"`
+	ConfigContract     types.String `tfsdk:"config_contract"`
+}
+
+// SchemaField describes the schema field data model.
+type SchemaField struct {
+	SelectOptions types.List   `tfsdk:"select_options"`
+	Name          types.String `tfsdk:"name"`
+	Label         types.String `tfsdk:"label"`
+	Type          types.String `tfsdk:"type"`
+	Default       types.String `tfsdk:"default"`
+	HelpText      types.String `tfsdk:"help_text"`
+}
+
+// SchemaSelectOption describes the schema select option data model.
+type SchemaSelectOption struct {
+	Name  types.String `tfsdk:"name"`
+	Hint  types.String `tfsdk:"hint"`
+	Value types.Int64  `tfsdk:"value"`
+}
+
+func (s ImplementationSchema) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"fields":              types.ListType{}.WithElementType(SchemaField{}.getType()),
+			"implementation_name": types.StringType,
+			"config_contract":     types.StringType,
+		})
+}
+
+func (f SchemaField) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"select_options": types.ListType{}.WithElementType(SchemaSelectOption{}.getType()),
+			"name":           types.StringType,
+			"label":          types.StringType,
+			"type":           types.StringType,
+			"default":        types.StringType,
+			"help_text":      types.StringType,
+		})
+}
+
+func (o SchemaSelectOption) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":  types.StringType,
+			"hint":  types.StringType,
+			"value": types.Int64Type,
+		})
+}
+
+// implementationSchemasAttributes returns the attributes of the schema data sources.
+func implementationSchemasAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"implementations": schema.MapNestedAttribute{
+			MarkdownDescription: "Implementation schemas, keyed by implementation.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"implementation_name": schema.StringAttribute{
+						MarkdownDescription: "Implementation display name.",
+						Computed:            true,
+					},
+					"config_contract": schema.StringAttribute{
+						MarkdownDescription: "Configuration template.",
+						Computed:            true,
+					},
+					"fields": schema.ListNestedAttribute{
+						MarkdownDescription: "Fields, in display order.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "API field name.",
+									Computed:            true,
+								},
+								"label": schema.StringAttribute{
+									MarkdownDescription: "Field label.",
+									Computed:            true,
+								},
+								"type": schema.StringAttribute{
+									MarkdownDescription: "Field type, e.g. `textbox`, `number`, `checkbox`, `select` or `password`.",
+									Computed:            true,
+								},
+								"default": schema.StringAttribute{
+									MarkdownDescription: "Default value. Lists are in JSON format.",
+									Computed:            true,
+								},
+								"help_text": schema.StringAttribute{
+									MarkdownDescription: "Help text.",
+									Computed:            true,
+								},
+								"select_options": schema.ListNestedAttribute{
+									MarkdownDescription: "Select options.",
+									Computed:            true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"value": schema.Int64Attribute{
+												MarkdownDescription: "Option value.",
+												Computed:            true,
+											},
+											"name": schema.StringAttribute{
+												MarkdownDescription: "Option name.",
+												Computed:            true,
+											},
+											"hint": schema.StringAttribute{
+												MarkdownDescription: "Option hint.",
+												Computed:            true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// writeImplementationSchemas maps the response of a schema endpoint.
+func writeImplementationSchemas[T any, P interface {
+	*T
+	implementationSchemaDetails
+}](ctx context.Context, schemas []T, diags *diag.Diagnostics) ImplementationSchemas {
+	implementations := make(map[string]ImplementationSchema, len(schemas))
+
+	for i := range schemas {
+		s := P(&schemas[i])
+		implementations[s.GetImplementation()] = writeImplementationSchema(ctx, s, diags)
+	}
+
+	value, localDiag := types.MapValueFrom(ctx, ImplementationSchema{}.getType(), implementations)
+	diags.Append(localDiag...)
+
+	return ImplementationSchemas{Implementations: value, ID: types.StringValue(strconv.Itoa(len(schemas)))}
+}
+
+func writeImplementationSchema(ctx context.Context, s implementationSchemaDetails, diags *diag.Diagnostics) ImplementationSchema {
+	fields := make([]SchemaField, len(s.GetFields()))
+	for i, f := range s.GetFields() {
+		fields[i].write(ctx, &f, diags)
+	}
+
+	value, localDiag := types.ListValueFrom(ctx, SchemaField{}.getType(), fields)
+	diags.Append(localDiag...)
+
+	return ImplementationSchema{
+		Fields:             value,
+		ImplementationName: types.StringValue(s.GetImplementationName()),
+		ConfigContract:     types.StringValue(s.GetConfigContract()),
+	}
+}
+
+func (f *SchemaField) write(ctx context.Context, field *lidarr.Field, diags *diag.Diagnostics) {
+	options := make([]SchemaSelectOption, len(field.GetSelectOptions()))
+	for i, o := range field.GetSelectOptions() {
+		options[i] = SchemaSelectOption{
+			Name:  types.StringValue(o.GetName()),
+			Hint:  types.StringValue(o.GetHint()),
+			Value: types.Int64Value(int64(o.GetValue())),
+		}
+	}
+
+	var localDiag diag.Diagnostics
+
+	f.SelectOptions, localDiag = types.ListValueFrom(ctx, SchemaSelectOption{}.getType(), options)
+	diags.Append(localDiag...)
+
+	f.Name = types.StringValue(field.GetName())
+	f.Label = types.StringValue(field.GetLabel())
+	f.Type = types.StringValue(field.GetType())
+	f.HelpText = types.StringValue(field.GetHelpText())
+	f.Default = types.StringNull()
+
+	if field.GetValue() != nil {
+		f.Default = types.StringValue(helpers.FieldValueString(field.GetValue()))
+	}
+}
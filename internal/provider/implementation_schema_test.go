@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteImplementationSchemas(t *testing.T) {
+	t.Parallel()
+
+	field := lidarr.NewField()
+	field.SetName("categories")
+	field.SetLabel("Categories")
+	field.SetType("select")
+	field.SetHelpText("Categories to search.")
+	field.SetValue([]interface{}{float64(3000)})
+	field.SetSelectOptions([]lidarr.SelectOption{{Value: lidarr.PtrInt32(3000), Name: *lidarr.NewNullableString(lidarr.PtrString("Audio"))}})
+
+	empty := lidarr.NewField()
+	empty.SetName("apiKey")
+
+	indexer := lidarr.NewIndexerResource()
+	indexer.SetImplementation("Torznab")
+	indexer.SetImplementationName("Torznab")
+	indexer.SetConfigContract("TorznabSettings")
+	indexer.SetFields([]lidarr.Field{*field, *empty})
+
+	var diags diag.Diagnostics
+
+	schemas := writeImplementationSchemas(context.Background(), []lidarr.IndexerResource{*indexer}, &diags)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "1", schemas.ID.ValueString())
+
+	var implementations map[string]ImplementationSchema
+
+	assert.False(t, schemas.Implementations.ElementsAs(context.Background(), &implementations, false).HasError())
+	assert.Equal(t, "TorznabSettings", implementations["Torznab"].ConfigContract.ValueString())
+
+	var fields []SchemaField
+
+	assert.False(t, implementations["Torznab"].Fields.ElementsAs(context.Background(), &fields, false).HasError())
+	assert.Len(t, fields, 2)
+	assert.Equal(t, "[3000]", fields[0].Default.ValueString())
+	assert.Equal(t, "Categories to search.", fields[0].HelpText.ValueString())
+	assert.True(t, fields[1].Default.IsNull())
+
+	var options []SchemaSelectOption
+
+	assert.False(t, fields[0].SelectOptions.ElementsAs(context.Background(), &options, false).HasError())
+	assert.Len(t, options, 1)
+	assert.Equal(t, int64(3000), options[0].Value.ValueInt64())
+	assert.Equal(t, "Audio", options[0].Name.ValueString())
+}
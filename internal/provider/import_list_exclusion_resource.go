@@ -99,7 +99,7 @@ func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.ImportListExclusionAPI.CreateImportListExclusion(r.auth).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListExclusionResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, importListExclusionResourceName, err, validationAttribute(importListExclusionResourceName))
 
 		return
 	}
@@ -121,8 +121,15 @@ func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get importListExclusion current value
-	response, _, err := r.client.ImportListExclusionAPI.GetImportListExclusionById(r.auth, int32(importListExclusion.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListExclusionAPI.GetImportListExclusionById(r.auth, int32(importListExclusion.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(importListExclusionResourceName, importListExclusion.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionResourceName, err))
 
 		return
@@ -149,7 +156,7 @@ func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.U
 
 	response, _, err := r.client.ImportListExclusionAPI.UpdateImportListExclusion(r.auth, strconv.Itoa(int(request.GetId()))).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListExclusionResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, importListExclusionResourceName, err, validationAttribute(importListExclusionResourceName))
 
 		return
 	}
@@ -0,0 +1,242 @@
+// Code generated by fieldgen from fieldspecs/import_list.json; DO NOT EDIT.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeFields populates the ImportList attributes from the API fields.
+func (i *ImportList) writeFields(fields []lidarr.Field, diags *diag.Diagnostics) {
+	for _, field := range fields {
+		var err error
+
+		switch field.GetName() {
+		case "count":
+			i.Count, err = helpers.Int64FieldValue(&field)
+		case "accessToken":
+			i.AccessToken, err = helpers.SensitiveFieldValue(&field, i.AccessToken)
+		case "refreshToken":
+			i.RefreshToken, err = helpers.SensitiveFieldValue(&field, i.RefreshToken)
+		case "apiKey":
+			i.APIKey, err = helpers.SensitiveFieldValue(&field, i.APIKey)
+		case "userId":
+			i.UserID, err = helpers.StringFieldValue(&field, i.UserID)
+		case "tagId":
+			i.TagID, err = helpers.StringFieldValue(&field, i.TagID)
+		case "listId":
+			i.ListID, err = helpers.StringFieldValue(&field, i.ListID)
+		case "seriesId":
+			i.SeriesID, err = helpers.StringFieldValue(&field, i.SeriesID)
+		case "baseUrl":
+			i.BaseURL, err = helpers.StringFieldValue(&field, i.BaseURL)
+		case "expires":
+			i.Expires, err = helpers.StringFieldValue(&field, i.Expires)
+		case "profileIds":
+			i.ProfileIDs, err = helpers.Int64SetFieldValue(&field)
+		case "tagIds":
+			i.TagIDs, err = helpers.Int64SetFieldValue(&field)
+		case "playlistIds":
+			i.PlaylistIDs, err = helpers.StringSetFieldValue(&field)
+		}
+
+		if err != nil {
+			diags.AddError(helpers.FieldError, helpers.ParseFieldError(field.GetName(), err))
+		}
+	}
+}
+
+// readFields returns the API fields of the ImportList attributes.
+func (i *ImportList) readFields(ctx context.Context, diags *diag.Diagnostics) []lidarr.Field {
+	var fields []lidarr.Field
+
+	fields = helpers.AppendInt64Field(fields, "count", i.Count)
+	fields = helpers.AppendSensitiveField(fields, "accessToken", i.AccessToken)
+	fields = helpers.AppendSensitiveField(fields, "refreshToken", i.RefreshToken)
+	fields = helpers.AppendSensitiveField(fields, "apiKey", i.APIKey)
+	fields = helpers.AppendStringField(fields, "userId", i.UserID)
+	fields = helpers.AppendStringField(fields, "tagId", i.TagID)
+	fields = helpers.AppendStringField(fields, "listId", i.ListID)
+	fields = helpers.AppendStringField(fields, "seriesId", i.SeriesID)
+	fields = helpers.AppendStringField(fields, "baseUrl", i.BaseURL)
+	fields = helpers.AppendStringField(fields, "expires", i.Expires)
+	fields = helpers.AppendInt64SetField(ctx, fields, "profileIds", i.ProfileIDs, diags)
+	fields = helpers.AppendInt64SetField(ctx, fields, "tagIds", i.TagIDs, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "playlistIds", i.PlaylistIDs, diags)
+
+	return fields
+}
+
+// maskFields appends the masked value of the secrets set in the prior state but not in the plan, so that the API keeps them.
+func (i *ImportList) maskFields(fields []lidarr.Field, prior *ImportList) []lidarr.Field {
+	fields = helpers.AppendMaskedField(fields, "accessToken", i.AccessToken, prior.AccessToken)
+	fields = helpers.AppendMaskedField(fields, "refreshToken", i.RefreshToken, prior.RefreshToken)
+	fields = helpers.AppendMaskedField(fields, "apiKey", i.APIKey, prior.APIKey)
+
+	return fields
+}
+
+// withImportListFieldAttributes adds the field attributes to the ImportList resource schema.
+func withImportListFieldAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["count_list"] = schema.Int64Attribute{
+		MarkdownDescription: "Elements to pull from list.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["access_token"] = schema.StringAttribute{
+		MarkdownDescription: "Access token.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["refresh_token"] = schema.StringAttribute{
+		MarkdownDescription: "Refresh token.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["api_key"] = schema.StringAttribute{
+		MarkdownDescription: "API key.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["user_id"] = schema.StringAttribute{
+		MarkdownDescription: "User ID.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["tag_id"] = schema.StringAttribute{
+		MarkdownDescription: "Tag ID.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["list_id"] = schema.StringAttribute{
+		MarkdownDescription: "List ID.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["series_id"] = schema.StringAttribute{
+		MarkdownDescription: "Series ID.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["base_url"] = schema.StringAttribute{
+		MarkdownDescription: "Base URL.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["expires"] = schema.StringAttribute{
+		MarkdownDescription: "Expires.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["profile_ids"] = schema.SetAttribute{
+		MarkdownDescription: "Profile IDs.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["tag_ids"] = schema.SetAttribute{
+		MarkdownDescription: "Tag IDs.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["playlist_ids"] = schema.SetAttribute{
+		MarkdownDescription: "Playlist IDs.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+
+	return attributes
+}
+
+// withImportListFieldDataSourceAttributes adds the field attributes to the ImportList data source schemas.
+func withImportListFieldDataSourceAttributes(attributes map[string]dataSourceSchema.Attribute) map[string]dataSourceSchema.Attribute {
+	attributes["count_list"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Elements to pull from list.",
+		Computed:            true,
+	}
+	attributes["access_token"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Access token.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["refresh_token"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Refresh token.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["api_key"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "API key.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["user_id"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "User ID.",
+		Computed:            true,
+	}
+	attributes["tag_id"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Tag ID.",
+		Computed:            true,
+	}
+	attributes["list_id"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "List ID.",
+		Computed:            true,
+	}
+	attributes["series_id"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Series ID.",
+		Computed:            true,
+	}
+	attributes["base_url"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Base URL.",
+		Computed:            true,
+	}
+	attributes["expires"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Expires.",
+		Computed:            true,
+	}
+	attributes["profile_ids"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Profile IDs.",
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["tag_ids"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Tag IDs.",
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["playlist_ids"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Playlist IDs.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+
+	return attributes
+}
+
+// importListFieldNames maps the API field names to the ImportList attributes managing them.
+var importListFieldNames = map[string]string{
+	"count":        "count_list",
+	"accessToken":  "access_token",
+	"refreshToken": "refresh_token",
+	"apiKey":       "api_key",
+	"userId":       "user_id",
+	"tagId":        "tag_id",
+	"listId":       "list_id",
+	"seriesId":     "series_id",
+	"baseUrl":      "base_url",
+	"expires":      "expires",
+	"profileIds":   "profile_ids",
+	"tagIds":       "tag_ids",
+	"playlistIds":  "playlist_ids",
+}
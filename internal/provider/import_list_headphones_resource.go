@@ -37,8 +37,9 @@ func NewImportListHeadphonesResource() resource.Resource {
 
 // ImportListHeadphonesResource defines the import list implementation.
 type ImportListHeadphonesResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListHeadphones describes the import list data model.
@@ -57,6 +58,7 @@ type ImportListHeadphones struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListHeadphones) toImportList() *ImportList {
@@ -167,6 +169,7 @@ func (r *ImportListHeadphonesResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -192,6 +195,7 @@ func (r *ImportListHeadphonesResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -208,9 +212,17 @@ func (r *ImportListHeadphonesResource) Create(ctx context.Context, req resource.
 	// Create new ImportListHeadphones
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListHeadphonesResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createImportList(r.auth, r.client, request, importListHeadphonesResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListHeadphonesResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, importListHeadphonesResourceName, err, fieldValidationAttribute(importListHeadphonesResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -232,8 +244,15 @@ func (r *ImportListHeadphonesResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get ImportListHeadphones current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(importListHeadphonesResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListHeadphonesResourceName, err))
 
 		return
@@ -246,10 +265,11 @@ func (r *ImportListHeadphonesResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *ImportListHeadphonesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var importList *ImportListHeadphones
+	// Get plan and state values
+	var importList, prior *ImportListHeadphones
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -257,10 +277,19 @@ func (r *ImportListHeadphonesResource) Update(ctx context.Context, req resource.
 
 	// Update ImportListHeadphones
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Fields = importList.toImportList().maskFields(request.Fields, prior.toImportList())
+
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListHeadphonesResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, err := updateImportList(r.auth, r.client, request, importListHeadphonesResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListHeadphonesResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, importListHeadphonesResourceName, err, fieldValidationAttribute(importListHeadphonesResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -293,7 +322,9 @@ func (r *ImportListHeadphonesResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *ImportListHeadphonesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return importListIDByName(r.auth, r.client, name, importListHeadphonesImplementation)
+	})
 	tflog.Trace(ctx, "imported "+importListHeadphonesResourceName+": "+req.ID)
 }
 
@@ -37,8 +37,9 @@ func NewImportListLastFMTagResource() resource.Resource {
 
 // ImportListLastFMTagResource defines the import list implementation.
 type ImportListLastFMTagResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListLastFMTag describes the import list data model.
@@ -57,6 +58,7 @@ type ImportListLastFMTag struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListLastFMTag) toImportList() *ImportList {
@@ -167,6 +169,7 @@ func (r *ImportListLastFMTagResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -191,6 +194,7 @@ func (r *ImportListLastFMTagResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -207,9 +211,17 @@ func (r *ImportListLastFMTagResource) Create(ctx context.Context, req resource.C
 	// Create new ImportListLastFMTag
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListLastFMTagResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createImportList(r.auth, r.client, request, importListLastFMTagResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListLastFMTagResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, importListLastFMTagResourceName, err, fieldValidationAttribute(importListLastFMTagResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -231,8 +243,15 @@ func (r *ImportListLastFMTagResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get ImportListLastFMTag current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(importListLastFMTagResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListLastFMTagResourceName, err))
 
 		return
@@ -257,9 +276,17 @@ func (r *ImportListLastFMTagResource) Update(ctx context.Context, req resource.U
 	// Update ImportListLastFMTag
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListLastFMTagResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateImportList(r.auth, r.client, request, importListLastFMTagResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListLastFMTagResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, importListLastFMTagResourceName, err, fieldValidationAttribute(importListLastFMTagResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -292,7 +319,9 @@ func (r *ImportListLastFMTagResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListLastFMTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return importListIDByName(r.auth, r.client, name, importListLastFMTagImplementation)
+	})
 	tflog.Trace(ctx, "imported "+importListLastFMTagResourceName+": "+req.ID)
 }
 
@@ -37,8 +37,9 @@ func NewImportListLastFMUserResource() resource.Resource {
 
 // ImportListLastFMUserResource defines the import list implementation.
 type ImportListLastFMUserResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListLastFMUser describes the import list data model.
@@ -57,6 +58,7 @@ type ImportListLastFMUser struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListLastFMUser) toImportList() *ImportList {
@@ -167,6 +169,7 @@ func (r *ImportListLastFMUserResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -191,6 +194,7 @@ func (r *ImportListLastFMUserResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -207,9 +211,17 @@ func (r *ImportListLastFMUserResource) Create(ctx context.Context, req resource.
 	// Create new ImportListLastFMUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListLastFMUserResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createImportList(r.auth, r.client, request, importListLastFMUserResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListLastFMUserResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, importListLastFMUserResourceName, err, fieldValidationAttribute(importListLastFMUserResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -231,8 +243,15 @@ func (r *ImportListLastFMUserResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get ImportListLastFMUser current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(importListLastFMUserResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListLastFMUserResourceName, err))
 
 		return
@@ -257,9 +276,17 @@ func (r *ImportListLastFMUserResource) Update(ctx context.Context, req resource.
 	// Update ImportListLastFMUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListLastFMUserResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateImportList(r.auth, r.client, request, importListLastFMUserResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListLastFMUserResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, importListLastFMUserResourceName, err, fieldValidationAttribute(importListLastFMUserResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -292,7 +319,9 @@ func (r *ImportListLastFMUserResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *ImportListLastFMUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return importListIDByName(r.auth, r.client, name, importListLastFMUserImplementation)
+	})
 	tflog.Trace(ctx, "imported "+importListLastFMUserResourceName+": "+req.ID)
 }
 
@@ -37,8 +37,9 @@ func NewImportListLidarrResource() resource.Resource {
 
 // ImportListLidarrResource defines the import list implementation.
 type ImportListLidarrResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListLidarr describes the import list data model.
@@ -59,6 +60,7 @@ type ImportListLidarr struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListLidarr) toImportList() *ImportList {
@@ -173,6 +175,7 @@ func (r *ImportListLidarrResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -210,6 +213,7 @@ func (r *ImportListLidarrResource) Configure(ctx context.Context, req resource.C
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -226,9 +230,17 @@ func (r *ImportListLidarrResource) Create(ctx context.Context, req resource.Crea
 	// Create new ImportListLidarr
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListLidarrResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createImportList(r.auth, r.client, request, importListLidarrResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListLidarrResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, importListLidarrResourceName, err, fieldValidationAttribute(importListLidarrResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -250,8 +262,15 @@ func (r *ImportListLidarrResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get ImportListLidarr current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(importListLidarrResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListLidarrResourceName, err))
 
 		return
@@ -264,10 +283,11 @@ func (r *ImportListLidarrResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *ImportListLidarrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var importList *ImportListLidarr
+	// Get plan and state values
+	var importList, prior *ImportListLidarr
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -275,10 +295,19 @@ func (r *ImportListLidarrResource) Update(ctx context.Context, req resource.Upda
 
 	// Update ImportListLidarr
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Fields = importList.toImportList().maskFields(request.Fields, prior.toImportList())
+
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListLidarrResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, err := updateImportList(r.auth, r.client, request, importListLidarrResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListLidarrResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, importListLidarrResourceName, err, fieldValidationAttribute(importListLidarrResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -311,7 +340,9 @@ func (r *ImportListLidarrResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ImportListLidarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return importListIDByName(r.auth, r.client, name, importListLidarrImplementation)
+	})
 	tflog.Trace(ctx, "imported "+importListLidarrResourceName+": "+req.ID)
 }
 
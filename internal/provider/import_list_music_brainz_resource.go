@@ -37,8 +37,9 @@ func NewImportListMusicBrainzResource() resource.Resource {
 
 // ImportListMusicBrainzResource defines the import list implementation.
 type ImportListMusicBrainzResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListMusicBrainz describes the import list data model.
@@ -56,6 +57,7 @@ type ImportListMusicBrainz struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListMusicBrainz) toImportList() *ImportList {
@@ -164,6 +166,7 @@ func (r *ImportListMusicBrainzResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -184,6 +187,7 @@ func (r *ImportListMusicBrainzResource) Configure(ctx context.Context, req resou
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -200,9 +204,17 @@ func (r *ImportListMusicBrainzResource) Create(ctx context.Context, req resource
 	// Create new ImportListMusicBrainz
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListMusicBrainzResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createImportList(r.auth, r.client, request, importListMusicBrainzResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListMusicBrainzResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, importListMusicBrainzResourceName, err, fieldValidationAttribute(importListMusicBrainzResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -224,8 +236,15 @@ func (r *ImportListMusicBrainzResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get ImportListMusicBrainz current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(importListMusicBrainzResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListMusicBrainzResourceName, err))
 
 		return
@@ -250,9 +269,17 @@ func (r *ImportListMusicBrainzResource) Update(ctx context.Context, req resource
 	// Update ImportListMusicBrainz
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListMusicBrainzResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateImportList(r.auth, r.client, request, importListMusicBrainzResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListMusicBrainzResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, importListMusicBrainzResourceName, err, fieldValidationAttribute(importListMusicBrainzResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -285,7 +312,9 @@ func (r *ImportListMusicBrainzResource) Delete(ctx context.Context, req resource
 }
 
 func (r *ImportListMusicBrainzResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return importListIDByName(r.auth, r.client, name, importListMusicBrainzImplementation)
+	})
 	tflog.Trace(ctx, "imported "+importListMusicBrainzResourceName+": "+req.ID)
 }
 
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
@@ -25,14 +26,10 @@ const importListResourceName = "import_list"
 var (
 	_ resource.Resource                = &ImportListResource{}
 	_ resource.ResourceWithImportState = &ImportListResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListResource{}
 )
 
-var importListFields = helpers.Fields{
-	Ints:         []string{"count"},
-	Strings:      []string{"baseUrl", "apiKey", "tagId", "userId", "listId", "seriesId", "accessToken", "refreshToken", "expires"},
-	IntSlices:    []string{"profileIds", "tagIds"},
-	StringSlices: []string{"playlistIds"},
-}
+//go:generate go run ../../tools/fieldgen -spec fieldspecs/import_list.json -out import_list_fields_gen.go
 
 func NewImportListResource() resource.Resource {
 	return &ImportListResource{}
@@ -40,8 +37,9 @@ func NewImportListResource() resource.Resource {
 
 // ImportListResource defines the download client implementation.
 type ImportListResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportList describes the download client data model.
@@ -76,6 +74,14 @@ type ImportList struct {
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
 }
 
+// importListResourceModel describes the generic import list resource data model.
+type importListResourceModel struct {
+	Fields          types.Map  `tfsdk:"fields"`
+	SensitiveFields types.Map  `tfsdk:"sensitive_fields"`
+	TestOnApply     types.Bool `tfsdk:"test_on_apply"`
+	ImportList
+}
+
 func (i ImportList) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -117,7 +123,7 @@ func (r *ImportListResource) Metadata(_ context.Context, req resource.MetadataRe
 func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nGeneric Import List resource. When possible use a specific resource instead.\nFor more information refer to [Import List](https://wiki.servarr.com/lidarr/settings#import-lists).",
-		Attributes: map[string]schema.Attribute{
+		Attributes: withImportListFieldAttributes(map[string]schema.Attribute{
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -195,6 +201,9 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"fields":           fieldsAttribute(),
+			"sensitive_fields": sensitiveFieldsAttribute(),
+			"test_on_apply":    testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -202,79 +211,7 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			// Field values
-			"count_list": schema.Int64Attribute{
-				MarkdownDescription: "Elements to pull from list.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"access_token": schema.StringAttribute{
-				MarkdownDescription: "Access token.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"refresh_token": schema.StringAttribute{
-				MarkdownDescription: "Refresh token.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"user_id": schema.StringAttribute{
-				MarkdownDescription: "User ID.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"tag_id": schema.StringAttribute{
-				MarkdownDescription: "Tag ID.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"list_id": schema.StringAttribute{
-				MarkdownDescription: "List ID.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"series_id": schema.StringAttribute{
-				MarkdownDescription: "Series ID.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Base URL.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"expires": schema.StringAttribute{
-				MarkdownDescription: "Expires.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"profile_ids": schema.SetAttribute{
-				MarkdownDescription: "Profile IDs.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"tag_ids": schema.SetAttribute{
-				MarkdownDescription: "Tag IDs.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"playlist_ids": schema.SetAttribute{
-				MarkdownDescription: "Playlist IDs.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
+		}),
 	}
 }
 
@@ -282,12 +219,30 @@ func (r *ImportListResource) Configure(ctx context.Context, req resource.Configu
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
+	}
+}
+
+func (r *ImportListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or if provider is not configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var importList *importListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+
+	if resp.Diagnostics.HasError() || importList.Implementation.IsUnknown() {
+		return
 	}
+
+	r.freeFields(ctx, importList, &resp.Diagnostics)
 }
 
 func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var importList *ImportList
+	var importList *importListResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
 
@@ -297,10 +252,19 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Create new ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, importList, &resp.Diagnostics))
+
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, err := createImportList(r.auth, r.client, request, importListResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, importListResourceName, err, fieldValidationAttribute(importListResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -308,16 +272,19 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Trace(ctx, "created "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state importListResourceModel
 
-	state.writeSensitive(importList)
+	state.writeSensitive(&importList.ImportList)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = importList.TestOnApply
+	state.Fields = writeFreeFields(ctx, importList.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, importList.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var importList *ImportList
+	var importList *importListResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &importList)...)
 
@@ -326,8 +293,15 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get ImportList current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(importListResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListResourceName, err))
 
 		return
@@ -336,18 +310,22 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Trace(ctx, "read "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state importListResourceModel
 
-	state.writeSensitive(importList)
+	state.writeSensitive(&importList.ImportList)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = importList.TestOnApply
+	state.Fields = writeFreeFields(ctx, importList.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, importList.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var importList *ImportList
+	// Get plan and state values
+	var importList, prior *importListResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -355,10 +333,20 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Update ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Fields = importList.maskFields(request.Fields, &prior.ImportList)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, importList, &resp.Diagnostics))
+
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListResourceName, &resp.Diagnostics)
+	}
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateImportList(r.auth, r.client, request, importListResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, importListResourceName, err, fieldValidationAttribute(importListResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -366,10 +354,13 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	tflog.Trace(ctx, "updated "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state importListResourceModel
 
-	state.writeSensitive(importList)
+	state.writeSensitive(&importList.ImportList)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = importList.TestOnApply
+	state.Fields = writeFreeFields(ctx, importList.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, importList.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -395,10 +386,62 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ImportListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return importListIDByName(r.auth, r.client, name, "")
+	})
 	tflog.Trace(ctx, "imported "+importListResourceName+": "+req.ID)
 }
 
+// testImportList tests the import list connection, reporting the validation failures on the related attributes.
+func testImportList(auth context.Context, client *lidarr.APIClient, importList *lidarr.ImportListResource, name string, diags *diag.Diagnostics) {
+	if _, err := client.ImportListAPI.TestImportList(auth).ImportListResource(*importList).Execute(); err != nil {
+		helpers.AddValidationDiagnostics(diags, helpers.TestConnection, name, err, fieldValidationAttribute(name, importListFieldNames, importList.GetFields()))
+	}
+}
+
+// createImportList creates the import list. When Lidarr only reports validation warnings,
+// they are reported as such and the import list is saved anyway.
+func createImportList(auth context.Context, client *lidarr.APIClient, importList *lidarr.ImportListResource, name string, diags *diag.Diagnostics) (*lidarr.ImportListResource, error) {
+	response, _, err := client.ImportListAPI.CreateImportList(auth).ImportListResource(*importList).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Create, name, err, fieldValidationAttribute(name, importListFieldNames, importList.GetFields()))
+		response, _, err = client.ImportListAPI.CreateImportList(auth).ImportListResource(*importList).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// updateImportList updates the import list. When Lidarr only reports validation warnings,
+// they are reported as such and the import list is saved anyway.
+func updateImportList(auth context.Context, client *lidarr.APIClient, importList *lidarr.ImportListResource, name string, diags *diag.Diagnostics) (*lidarr.ImportListResource, error) {
+	response, _, err := client.ImportListAPI.UpdateImportList(auth, importList.GetId()).ImportListResource(*importList).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Update, name, err, fieldValidationAttribute(name, importListFieldNames, importList.GetFields()))
+		response, _, err = client.ImportListAPI.UpdateImportList(auth, importList.GetId()).ImportListResource(*importList).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// freeFields validates the free-form fields against the implementation schema and returns their API representation.
+func (r *ImportListResource) freeFields(ctx context.Context, importList *importListResourceModel, diags *diag.Diagnostics) []lidarr.Field {
+	if !hasFreeFields(importList.Fields, importList.SensitiveFields) {
+		return nil
+	}
+
+	schemas, _, err := r.client.ImportListAPI.ListImportListSchema(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, importListResourceName+" schema", err))
+
+		return nil
+	}
+
+	fields, found := schemaFields(schemas, importList.Implementation.ValueString())
+	validateImplementation(found, importList.Implementation.ValueString(), diags)
+
+	return readFreeFields(ctx, importList.Fields, importList.SensitiveFields, fields, importListFieldNames, diags)
+}
+
 func (i *ImportList) write(ctx context.Context, importList *lidarr.ImportListResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -422,7 +465,7 @@ func (i *ImportList) write(ctx context.Context, importList *lidarr.ImportListRes
 	i.ProfileIDs = types.SetValueMust(types.Int64Type, nil)
 	i.TagIDs = types.SetValueMust(types.Int64Type, nil)
 	i.PlaylistIDs = types.SetValueMust(types.StringType, nil)
-	helpers.WriteFields(ctx, i, importList.GetFields(), importListFields)
+	i.writeFields(importList.GetFields(), diags)
 }
 
 func (i *ImportList) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.ImportListResource {
@@ -442,7 +485,7 @@ func (i *ImportList) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.
 	list.SetImplementation(i.Implementation.ValueString())
 	list.SetName(i.Name.ValueString())
 	diags.Append(i.Tags.ElementsAs(ctx, &list.Tags, true)...)
-	list.SetFields(helpers.ReadFields(ctx, i, importListFields))
+	list.SetFields(i.readFields(ctx, diags))
 
 	return list
 }
@@ -453,3 +496,13 @@ func (i *ImportList) writeSensitive(importList *ImportList) {
 		i.APIKey = importList.APIKey
 	}
 }
+
+// importListIDByName returns the ID of the import list with the given name, of the given implementation unless empty.
+func importListIDByName(auth context.Context, client *lidarr.APIClient, name, implementation string) (int32, error) {
+	response, _, err := client.ImportListAPI.ListImportList(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, importListResourceName, err))
+	}
+
+	return helpers.FindImplementationIDByName(importListResourceName, name, implementation, response, (*lidarr.ImportListResource).GetName, (*lidarr.ImportListResource).GetImplementation, (*lidarr.ImportListResource).GetId)
+}
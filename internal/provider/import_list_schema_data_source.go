@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const importListSchemaDataSourceName = "import_list_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImportListSchemaDataSource{}
+
+func NewImportListSchemaDataSource() datasource.DataSource {
+	return &ImportListSchemaDataSource{}
+}
+
+// ImportListSchemaDataSource defines the import list schema implementation.
+type ImportListSchemaDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+func (d *ImportListSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + importListSchemaDataSourceName
+}
+
+func (d *ImportListSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Import Lists -->\nList the fields of each Import list implementation, as exposed by the Lidarr schema endpoint.",
+		Attributes:          implementationSchemasAttributes(),
+	}
+}
+
+func (d *ImportListSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *ImportListSchemaDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get import list schema current value
+	response, _, err := d.client.ImportListAPI.ListImportListSchema(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, importListSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+importListSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	schemas := writeImplementationSchemas(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, schemas)...)
+}
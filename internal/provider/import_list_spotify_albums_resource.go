@@ -37,8 +37,9 @@ func NewImportListSpotifyAlbumsResource() resource.Resource {
 
 // ImportListSpotifyAlbumsResource defines the import list implementation.
 type ImportListSpotifyAlbumsResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListSpotifyAlbums describes the import list data model.
@@ -58,6 +59,7 @@ type ImportListSpotifyAlbums struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListSpotifyAlbums) toImportList() *ImportList {
@@ -170,6 +172,7 @@ func (r *ImportListSpotifyAlbumsResource) Schema(_ context.Context, _ resource.S
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -200,6 +203,7 @@ func (r *ImportListSpotifyAlbumsResource) Configure(ctx context.Context, req res
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -216,9 +220,17 @@ func (r *ImportListSpotifyAlbumsResource) Create(ctx context.Context, req resour
 	// Create new ImportListSpotifyAlbums
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListSpotifyAlbumsResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createImportList(r.auth, r.client, request, importListSpotifyAlbumsResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListSpotifyAlbumsResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, importListSpotifyAlbumsResourceName, err, fieldValidationAttribute(importListSpotifyAlbumsResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -240,8 +252,15 @@ func (r *ImportListSpotifyAlbumsResource) Read(ctx context.Context, req resource
 	}
 
 	// Get ImportListSpotifyAlbums current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(importListSpotifyAlbumsResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListSpotifyAlbumsResourceName, err))
 
 		return
@@ -254,10 +273,11 @@ func (r *ImportListSpotifyAlbumsResource) Read(ctx context.Context, req resource
 }
 
 func (r *ImportListSpotifyAlbumsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var importList *ImportListSpotifyAlbums
+	// Get plan and state values
+	var importList, prior *ImportListSpotifyAlbums
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -265,10 +285,19 @@ func (r *ImportListSpotifyAlbumsResource) Update(ctx context.Context, req resour
 
 	// Update ImportListSpotifyAlbums
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Fields = importList.toImportList().maskFields(request.Fields, prior.toImportList())
+
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListSpotifyAlbumsResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, err := updateImportList(r.auth, r.client, request, importListSpotifyAlbumsResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListSpotifyAlbumsResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, importListSpotifyAlbumsResourceName, err, fieldValidationAttribute(importListSpotifyAlbumsResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -301,7 +330,9 @@ func (r *ImportListSpotifyAlbumsResource) Delete(ctx context.Context, req resour
 }
 
 func (r *ImportListSpotifyAlbumsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return importListIDByName(r.auth, r.client, name, importListSpotifyAlbumsImplementation)
+	})
 	tflog.Trace(ctx, "imported "+importListSpotifyAlbumsResourceName+": "+req.ID)
 }
 
@@ -37,8 +37,9 @@ func NewImportListSpotifyPlaylistsResource() resource.Resource {
 
 // ImportListSpotifyPlaylistsResource defines the import list implementation.
 type ImportListSpotifyPlaylistsResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListSpotifyPlaylists describes the import list data model.
@@ -59,6 +60,7 @@ type ImportListSpotifyPlaylists struct {
 	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
 	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
 	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListSpotifyPlaylists) toImportList() *ImportList {
@@ -173,6 +175,7 @@ func (r *ImportListSpotifyPlaylistsResource) Schema(_ context.Context, _ resourc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -208,6 +211,7 @@ func (r *ImportListSpotifyPlaylistsResource) Configure(ctx context.Context, req
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -224,9 +228,17 @@ func (r *ImportListSpotifyPlaylistsResource) Create(ctx context.Context, req res
 	// Create new ImportListSpotifyPlaylists
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListSpotifyPlaylistsResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createImportList(r.auth, r.client, request, importListSpotifyPlaylistsResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListSpotifyPlaylistsResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, importListSpotifyPlaylistsResourceName, err, fieldValidationAttribute(importListSpotifyPlaylistsResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -248,8 +260,15 @@ func (r *ImportListSpotifyPlaylistsResource) Read(ctx context.Context, req resou
 	}
 
 	// Get ImportListSpotifyPlaylists current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(importListSpotifyPlaylistsResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListSpotifyPlaylistsResourceName, err))
 
 		return
@@ -262,10 +281,11 @@ func (r *ImportListSpotifyPlaylistsResource) Read(ctx context.Context, req resou
 }
 
 func (r *ImportListSpotifyPlaylistsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var importList *ImportListSpotifyPlaylists
+	// Get plan and state values
+	var importList, prior *ImportListSpotifyPlaylists
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -273,10 +293,19 @@ func (r *ImportListSpotifyPlaylistsResource) Update(ctx context.Context, req res
 
 	// Update ImportListSpotifyPlaylists
 	request := importList.read(ctx, &resp.Diagnostics)
+	request.Fields = importList.toImportList().maskFields(request.Fields, prior.toImportList())
+
+	if isTestOnApply(importList.TestOnApply, r.testOnApply) {
+		testImportList(r.auth, r.client, request, importListSpotifyPlaylistsResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, err := updateImportList(r.auth, r.client, request, importListSpotifyPlaylistsResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListSpotifyPlaylistsResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, importListSpotifyPlaylistsResourceName, err, fieldValidationAttribute(importListSpotifyPlaylistsResourceName, importListFieldNames, request.GetFields()))
 
 		return
 	}
@@ -309,7 +338,9 @@ func (r *ImportListSpotifyPlaylistsResource) Delete(ctx context.Context, req res
 }
 
 func (r *ImportListSpotifyPlaylistsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return importListIDByName(r.auth, r.client, name, importListSpotifyPlaylistsImplementation)
+	})
 	tflog.Trace(ctx, "imported "+importListSpotifyPlaylistsResourceName+": "+req.ID)
 }
 
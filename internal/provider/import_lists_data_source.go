@@ -50,7 +50,7 @@ func (d *ImportListsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				MarkdownDescription: "Import List list.",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: withImportListFieldDataSourceAttributes(map[string]schema.Attribute{
 						"enable_automatic_add": schema.BoolAttribute{
 							MarkdownDescription: "Enable automatic add flag.",
 							Computed:            true,
@@ -112,66 +112,7 @@ func (d *ImportListsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							MarkdownDescription: "Import List ID.",
 							Computed:            true,
 						},
-						// Field values
-						"count_list": schema.Int64Attribute{
-							MarkdownDescription: "Elements to pull from list.",
-							Computed:            true,
-						},
-						"access_token": schema.StringAttribute{
-							MarkdownDescription: "Access token.",
-							Computed:            true,
-							Sensitive:           true,
-						},
-						"refresh_token": schema.StringAttribute{
-							MarkdownDescription: "Refresh token.",
-							Computed:            true,
-							Sensitive:           true,
-						},
-						"api_key": schema.StringAttribute{
-							MarkdownDescription: "API key.",
-							Computed:            true,
-							Sensitive:           true,
-						},
-						"user_id": schema.StringAttribute{
-							MarkdownDescription: "User ID.",
-							Computed:            true,
-						},
-						"tag_id": schema.StringAttribute{
-							MarkdownDescription: "Tag ID.",
-							Computed:            true,
-						},
-						"list_id": schema.StringAttribute{
-							MarkdownDescription: "List ID.",
-							Computed:            true,
-						},
-						"series_id": schema.StringAttribute{
-							MarkdownDescription: "Series ID.",
-							Computed:            true,
-						},
-						"base_url": schema.StringAttribute{
-							MarkdownDescription: "Base URL.",
-							Computed:            true,
-						},
-						"expires": schema.StringAttribute{
-							MarkdownDescription: "Expires.",
-							Computed:            true,
-						},
-						"profile_ids": schema.SetAttribute{
-							MarkdownDescription: "Profile IDs.",
-							Computed:            true,
-							ElementType:         types.Int64Type,
-						},
-						"tag_ids": schema.SetAttribute{
-							MarkdownDescription: "Tag IDs.",
-							Computed:            true,
-							ElementType:         types.Int64Type,
-						},
-						"playlist_ids": schema.SetAttribute{
-							MarkdownDescription: "Playlist IDs.",
-							Computed:            true,
-							ElementType:         types.StringType,
-						},
-					},
+					}),
 				},
 			},
 		},
@@ -35,7 +35,7 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nSingle [Indexer](../resources/indexer).",
-		Attributes: map[string]schema.Attribute{
+		Attributes: withIndexerFieldDataSourceAttributes(map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Computed:            true,
@@ -77,99 +77,7 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
 			},
-			// Field values
-			"allow_zero_size": schema.BoolAttribute{
-				MarkdownDescription: "Allow zero size files.",
-				Computed:            true,
-			},
-			"ranked_only": schema.BoolAttribute{
-				MarkdownDescription: "Allow ranked only.",
-				Computed:            true,
-			},
-			"use_freeleech_token": schema.BoolAttribute{
-				MarkdownDescription: "Use freeleech token flag.",
-				Computed:            true,
-			},
-			"delay": schema.Int64Attribute{
-				MarkdownDescription: "Delay before grabbing.",
-				Computed:            true,
-			},
-			"minimum_seeders": schema.Int64Attribute{
-				MarkdownDescription: "Minimum seeders.",
-				Computed:            true,
-			},
-			"early_release_limit": schema.Int64Attribute{
-				MarkdownDescription: "Early release limit.",
-				Computed:            true,
-			},
-			"seed_time": schema.Int64Attribute{
-				MarkdownDescription: "Seed time.",
-				Computed:            true,
-			},
-			"discography_seed_time": schema.Int64Attribute{
-				MarkdownDescription: "Discography seed time.",
-				Computed:            true,
-			},
-			"seed_ratio": schema.Float64Attribute{
-				MarkdownDescription: "Seed ratio.",
-				Computed:            true,
-			},
-			"additional_parameters": schema.StringAttribute{
-				MarkdownDescription: "Additional parameters.",
-				Computed:            true,
-			},
-			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key.",
-				Computed:            true,
-			},
-			"api_user": schema.StringAttribute{
-				MarkdownDescription: "API User.",
-				Computed:            true,
-			},
-			"api_path": schema.StringAttribute{
-				MarkdownDescription: "API path.",
-				Computed:            true,
-			},
-			"user_id": schema.StringAttribute{
-				MarkdownDescription: "User ID.",
-				Computed:            true,
-			},
-			"rss_passkey": schema.StringAttribute{
-				MarkdownDescription: "RSS passkey.",
-				Computed:            true,
-			},
-			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Base URL.",
-				Computed:            true,
-			},
-			"captcha_token": schema.StringAttribute{
-				MarkdownDescription: "Captcha token.",
-				Computed:            true,
-			},
-			"cookie": schema.StringAttribute{
-				MarkdownDescription: "Cookie.",
-				Computed:            true,
-			},
-			"passkey": schema.StringAttribute{
-				MarkdownDescription: "Passkey.",
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Computed:            true,
-			},
-			"password": schema.StringAttribute{
-				MarkdownDescription: "Password.",
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"categories": schema.SetAttribute{
-				MarkdownDescription: "Series list.",
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-		},
+		}),
 	}
 }
 
@@ -35,8 +35,9 @@ func NewIndexerGazelleResource() resource.Resource {
 
 // IndexerGazelleResource defines the Gazelle indexer implementation.
 type IndexerGazelleResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // IndexerGazelle describes the Gazelle indexer data model.
@@ -57,6 +58,7 @@ type IndexerGazelle struct {
 	UseFreeleechToken       types.Bool    `tfsdk:"use_freeleech_token"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	TestOnApply             types.Bool    `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerGazelle) toIndexer() *Indexer {
@@ -140,6 +142,7 @@ func (r *IndexerGazelleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerGazelle ID.",
 				Computed:            true,
@@ -200,6 +203,7 @@ func (r *IndexerGazelleResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -216,9 +220,17 @@ func (r *IndexerGazelleResource) Create(ctx context.Context, req resource.Create
 	// Create new IndexerGazelle
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerGazelleResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, indexerGazelleResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerGazelleResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, indexerGazelleResourceName, err, fieldValidationAttribute(indexerGazelleResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -240,8 +252,15 @@ func (r *IndexerGazelleResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get IndexerGazelle current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(indexerGazelleResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerGazelleResourceName, err))
 
 		return
@@ -254,10 +273,11 @@ func (r *IndexerGazelleResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *IndexerGazelleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var indexer *IndexerGazelle
+	// Get plan and state values
+	var indexer, prior *IndexerGazelle
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -265,10 +285,19 @@ func (r *IndexerGazelleResource) Update(ctx context.Context, req resource.Update
 
 	// Update IndexerGazelle
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Fields = indexer.toIndexer().maskFields(request.Fields, prior.toIndexer())
+
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerGazelleResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, err := updateIndexer(r.auth, r.client, request, indexerGazelleResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerGazelleResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, indexerGazelleResourceName, err, fieldValidationAttribute(indexerGazelleResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -301,7 +330,9 @@ func (r *IndexerGazelleResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerGazelleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return indexerIDByName(r.auth, r.client, name, indexerGazelleImplementation)
+	})
 	tflog.Trace(ctx, "imported "+indexerGazelleResourceName+": "+req.ID)
 }
 
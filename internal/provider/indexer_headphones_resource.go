@@ -35,8 +35,9 @@ func NewIndexerHeadphonesResource() resource.Resource {
 
 // IndexerHeadphonesResource defines the Headphones indexer implementation.
 type IndexerHeadphonesResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // IndexerHeadphones describes the Headphones indexer data model.
@@ -52,6 +53,7 @@ type IndexerHeadphones struct {
 	EnableAutomaticSearch   types.Bool   `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool   `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool   `tfsdk:"enable_interactive_search"`
+	TestOnApply             types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerHeadphones) toIndexer() *Indexer {
@@ -125,6 +127,7 @@ func (r *IndexerHeadphonesResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerHeadphones ID.",
 				Computed:            true,
@@ -160,6 +163,7 @@ func (r *IndexerHeadphonesResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -176,9 +180,17 @@ func (r *IndexerHeadphonesResource) Create(ctx context.Context, req resource.Cre
 	// Create new IndexerHeadphones
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerHeadphonesResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, indexerHeadphonesResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerHeadphonesResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, indexerHeadphonesResourceName, err, fieldValidationAttribute(indexerHeadphonesResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -200,8 +212,15 @@ func (r *IndexerHeadphonesResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerHeadphones current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(indexerHeadphonesResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerHeadphonesResourceName, err))
 
 		return
@@ -214,10 +233,11 @@ func (r *IndexerHeadphonesResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *IndexerHeadphonesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var indexer *IndexerHeadphones
+	// Get plan and state values
+	var indexer, prior *IndexerHeadphones
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -225,10 +245,19 @@ func (r *IndexerHeadphonesResource) Update(ctx context.Context, req resource.Upd
 
 	// Update IndexerHeadphones
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Fields = indexer.toIndexer().maskFields(request.Fields, prior.toIndexer())
+
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerHeadphonesResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, err := updateIndexer(r.auth, r.client, request, indexerHeadphonesResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerHeadphonesResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, indexerHeadphonesResourceName, err, fieldValidationAttribute(indexerHeadphonesResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -261,7 +290,9 @@ func (r *IndexerHeadphonesResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerHeadphonesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return indexerIDByName(r.auth, r.client, name, indexerHeadphonesImplementation)
+	})
 	tflog.Trace(ctx, "imported "+indexerHeadphonesResourceName+": "+req.ID)
 }
 
@@ -35,8 +35,9 @@ func NewIndexerIptorrentsResource() resource.Resource {
 
 // IndexerIptorrentsResource defines the Iptorrents indexer implementation.
 type IndexerIptorrentsResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // IndexerIptorrents describes the Iptorrents indexer data model.
@@ -50,6 +51,7 @@ type IndexerIptorrents struct {
 	MinimumSeeders types.Int64   `tfsdk:"minimum_seeders"`
 	SeedTime       types.Int64   `tfsdk:"seed_time"`
 	EnableRss      types.Bool    `tfsdk:"enable_rss"`
+	TestOnApply    types.Bool    `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerIptorrents) toIndexer() *Indexer {
@@ -109,6 +111,7 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerIptorrents ID.",
 				Computed:            true,
@@ -144,6 +147,7 @@ func (r *IndexerIptorrentsResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -160,9 +164,17 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 	// Create new IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerIptorrentsResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, indexerIptorrentsResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerIptorrentsResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, indexerIptorrentsResourceName, err, fieldValidationAttribute(indexerIptorrentsResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -184,8 +196,15 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerIptorrents current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(indexerIptorrentsResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerIptorrentsResourceName, err))
 
 		return
@@ -210,9 +229,17 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 	// Update IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerIptorrentsResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateIndexer(r.auth, r.client, request, indexerIptorrentsResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerIptorrentsResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, indexerIptorrentsResourceName, err, fieldValidationAttribute(indexerIptorrentsResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -245,7 +272,9 @@ func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerIptorrentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return indexerIDByName(r.auth, r.client, name, indexerIptorrentsImplementation)
+	})
 	tflog.Trace(ctx, "imported "+indexerIptorrentsResourceName+": "+req.ID)
 }
 
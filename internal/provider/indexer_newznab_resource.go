@@ -35,8 +35,9 @@ func NewIndexerNewznabResource() resource.Resource {
 
 // IndexerNewznabResource defines the Newznab indexer implementation.
 type IndexerNewznabResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // IndexerNewznab describes the Newznab indexer data model.
@@ -53,6 +54,7 @@ type IndexerNewznab struct {
 	EnableRss               types.Bool   `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool   `tfsdk:"enable_interactive_search"`
 	EnableAutomaticSearch   types.Bool   `tfsdk:"enable_automatic_search"`
+	TestOnApply             types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerNewznab) toIndexer() *Indexer {
@@ -65,7 +67,7 @@ func (i IndexerNewznab) toIndexer() *Indexer {
 		Name:                    i.Name,
 		AdditionalParameters:    i.AdditionalParameters,
 		APIKey:                  i.APIKey,
-		APIPath:                 i.APIKey,
+		APIPath:                 i.APIPath,
 		BaseURL:                 i.BaseURL,
 		Categories:              i.Categories,
 		Tags:                    i.Tags,
@@ -128,6 +130,7 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerNewznab ID.",
 				Computed:            true,
@@ -170,6 +173,7 @@ func (r *IndexerNewznabResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -186,9 +190,17 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 	// Create new IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerNewznabResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, indexerNewznabResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNewznabResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, indexerNewznabResourceName, err, fieldValidationAttribute(indexerNewznabResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -210,8 +222,15 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get IndexerNewznab current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(indexerNewznabResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerNewznabResourceName, err))
 
 		return
@@ -224,10 +243,11 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var indexer *IndexerNewznab
+	// Get plan and state values
+	var indexer, prior *IndexerNewznab
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -235,10 +255,19 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 
 	// Update IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Fields = indexer.toIndexer().maskFields(request.Fields, prior.toIndexer())
+
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerNewznabResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, err := updateIndexer(r.auth, r.client, request, indexerNewznabResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNewznabResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, indexerNewznabResourceName, err, fieldValidationAttribute(indexerNewznabResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -271,7 +300,9 @@ func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerNewznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return indexerIDByName(r.auth, r.client, name, indexerNewznabImplementation)
+	})
 	tflog.Trace(ctx, "imported "+indexerNewznabResourceName+": "+req.ID)
 }
 
@@ -5,7 +5,9 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIndexerNewznabResource(t *testing.T) {
@@ -26,6 +28,7 @@ func TestAccIndexerNewznabResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_indexer_newznab.test", "priority", "25"),
 					resource.TestCheckResourceAttr("lidarr_indexer_newznab.test", "base_url", "https://lolo.sickbeard.com"),
+					resource.TestCheckResourceAttr("lidarr_indexer_newznab.test", "api_path", "/api"),
 					resource.TestCheckResourceAttrSet("lidarr_indexer_newznab.test", "id"),
 				),
 			},
@@ -47,6 +50,20 @@ func TestAccIndexerNewznabResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "lidarr_indexer_newznab.test",
+				ImportState:       true,
+				ImportStateId:     "name:newzabResourceTest",
+				ImportStateVerify: true,
+			},
+			// ImportState by missing name testing
+			{
+				ResourceName:  "lidarr_indexer_newznab.test",
+				ImportState:   true,
+				ImportStateId: "name:missing",
+				ExpectError:   regexp.MustCompile("Unable to find indexer"),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -62,3 +79,15 @@ func testAccIndexerNewznabResourceConfig(name, aSearch string) string {
 		categories = [5030, 5040]
 	}`, aSearch, name)
 }
+
+func TestIndexerNewznabToIndexer(t *testing.T) {
+	t.Parallel()
+
+	indexer := IndexerNewznab{
+		APIKey:  types.StringValue("key"),
+		APIPath: types.StringValue("/api"),
+	}.toIndexer()
+
+	assert.Equal(t, types.StringValue("key"), indexer.APIKey)
+	assert.Equal(t, types.StringValue("/api"), indexer.APIPath)
+}
@@ -35,8 +35,9 @@ func NewIndexerRedactedResource() resource.Resource {
 
 // IndexerRedactedResource defines the Redacted indexer implementation.
 type IndexerRedactedResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // IndexerRedacted describes the Redacted indexer data model.
@@ -55,6 +56,7 @@ type IndexerRedacted struct {
 	UseFreeleechToken       types.Bool    `tfsdk:"use_freeleech_token"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	TestOnApply             types.Bool    `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerRedacted) toIndexer() *Indexer {
@@ -134,6 +136,7 @@ func (r *IndexerRedactedResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerRedacted ID.",
 				Computed:            true,
@@ -185,6 +188,7 @@ func (r *IndexerRedactedResource) Configure(ctx context.Context, req resource.Co
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -201,9 +205,17 @@ func (r *IndexerRedactedResource) Create(ctx context.Context, req resource.Creat
 	// Create new IndexerRedacted
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerRedactedResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, indexerRedactedResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerRedactedResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, indexerRedactedResourceName, err, fieldValidationAttribute(indexerRedactedResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -225,8 +237,15 @@ func (r *IndexerRedactedResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get IndexerRedacted current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(indexerRedactedResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerRedactedResourceName, err))
 
 		return
@@ -239,10 +258,11 @@ func (r *IndexerRedactedResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *IndexerRedactedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var indexer *IndexerRedacted
+	// Get plan and state values
+	var indexer, prior *IndexerRedacted
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -250,10 +270,19 @@ func (r *IndexerRedactedResource) Update(ctx context.Context, req resource.Updat
 
 	// Update IndexerRedacted
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Fields = indexer.toIndexer().maskFields(request.Fields, prior.toIndexer())
+
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerRedactedResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, err := updateIndexer(r.auth, r.client, request, indexerRedactedResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerRedactedResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, indexerRedactedResourceName, err, fieldValidationAttribute(indexerRedactedResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -286,7 +315,9 @@ func (r *IndexerRedactedResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *IndexerRedactedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return indexerIDByName(r.auth, r.client, name, indexerRedactedImplementation)
+	})
 	tflog.Trace(ctx, "imported "+indexerRedactedResourceName+": "+req.ID)
 }
 
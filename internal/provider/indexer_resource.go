@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
@@ -25,17 +26,10 @@ const indexerResourceName = "indexer"
 var (
 	_ resource.Resource                = &IndexerResource{}
 	_ resource.ResourceWithImportState = &IndexerResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerResource{}
 )
 
-var indexerFields = helpers.Fields{
-	IntSlices:        []string{"categories"},
-	Bools:            []string{"useFreeleechToken", "rankedOnly", "allowZeroSize"},
-	Ints:             []string{"earlyReleaseLimit", "delay", "minimumSeeders", "seedTime", "discographySeedTime"},
-	IntsExceptions:   []string{"seedCriteria.seedTime", "seedCriteria.discographySeedTime"},
-	Strings:          []string{"apiKey", "apiPath", "baseUrl", "username", "passkey", "passKey", "password", "additionalParameters", "captchaToken", "cookie", "userId", "rssPasskey"},
-	Floats:           []string{"seedRatio"},
-	FloatsExceptions: []string{"seedCriteria.seedRatio"},
-}
+//go:generate go run ../../tools/fieldgen -spec fieldspecs/indexer.json -out indexer_fields_gen.go
 
 func NewIndexerResource() resource.Resource {
 	return &IndexerResource{}
@@ -43,8 +37,9 @@ func NewIndexerResource() resource.Resource {
 
 // IndexerResource defines the indexer implementation.
 type IndexerResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // Indexer describes the indexer data model.
@@ -83,6 +78,14 @@ type Indexer struct {
 	RankedOnly              types.Bool    `tfsdk:"ranked_only"`
 }
 
+// indexerResourceModel describes the generic indexer resource data model.
+type indexerResourceModel struct {
+	Fields          types.Map  `tfsdk:"fields"`
+	SensitiveFields types.Map  `tfsdk:"sensitive_fields"`
+	TestOnApply     types.Bool `tfsdk:"test_on_apply"`
+	Indexer
+}
+
 func (i Indexer) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -128,7 +131,7 @@ func (r *IndexerResource) Metadata(_ context.Context, req resource.MetadataReque
 func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nGeneric Indexer resource. When possible use a specific resource instead.\nFor more information refer to [Indexer](https://wiki.servarr.com/lidarr/settings#indexers) documentation.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: withIndexerFieldAttributes(map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -174,6 +177,9 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"fields":           fieldsAttribute(),
+			"sensitive_fields": sensitiveFieldsAttribute(),
+			"test_on_apply":    testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -181,122 +187,7 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			// Field values
-			"allow_zero_size": schema.BoolAttribute{
-				MarkdownDescription: "Allow zero size files.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"ranked_only": schema.BoolAttribute{
-				MarkdownDescription: "Allow ranked only.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"use_freeleech_token": schema.BoolAttribute{
-				MarkdownDescription: "Use freeleech token flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"delay": schema.Int64Attribute{
-				MarkdownDescription: "Delay before grabbing.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"minimum_seeders": schema.Int64Attribute{
-				MarkdownDescription: "Minimum seeders.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"early_release_limit": schema.Int64Attribute{
-				MarkdownDescription: "Early release limit.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"seed_time": schema.Int64Attribute{
-				MarkdownDescription: "Seed time.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"discography_seed_time": schema.Int64Attribute{
-				MarkdownDescription: "Discography seed time.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"seed_ratio": schema.Float64Attribute{
-				MarkdownDescription: "Seed ratio.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"additional_parameters": schema.StringAttribute{
-				MarkdownDescription: "Additional parameters.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"api_user": schema.StringAttribute{
-				MarkdownDescription: "API User.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"api_path": schema.StringAttribute{
-				MarkdownDescription: "API path.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"user_id": schema.StringAttribute{
-				MarkdownDescription: "User ID.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"rss_passkey": schema.StringAttribute{
-				MarkdownDescription: "RSS passkey.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Base URL.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"captcha_token": schema.StringAttribute{
-				MarkdownDescription: "Captcha token.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"cookie": schema.StringAttribute{
-				MarkdownDescription: "Cookie.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"passkey": schema.StringAttribute{
-				MarkdownDescription: "Passkey.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"password": schema.StringAttribute{
-				MarkdownDescription: "Password.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"categories": schema.SetAttribute{
-				MarkdownDescription: "Series list.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-		},
+		}),
 	}
 }
 
@@ -304,12 +195,30 @@ func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureR
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
+	}
+}
+
+func (r *IndexerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or if provider is not configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var indexer *indexerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() || indexer.Implementation.IsUnknown() {
+		return
 	}
+
+	r.freeFields(ctx, indexer, &resp.Diagnostics)
 }
 
 func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var indexer *Indexer
+	var indexer *indexerResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -319,10 +228,19 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Create new Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, indexer, &resp.Diagnostics))
+
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerResourceName, &resp.Diagnostics)
+	}
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, indexerResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, indexerResourceName, err, fieldValidationAttribute(indexerResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -330,16 +248,19 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	tflog.Trace(ctx, "created "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state indexerResourceModel
 
-	state.writeSensitive(indexer)
+	state.writeSensitive(&indexer.Indexer)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = indexer.TestOnApply
+	state.Fields = writeFreeFields(ctx, indexer.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, indexer.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var indexer *Indexer
+	var indexer *indexerResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
 
@@ -348,8 +269,15 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get Indexer current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(indexerResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerResourceName, err))
 
 		return
@@ -358,18 +286,22 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tflog.Trace(ctx, "read "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state indexerResourceModel
 
-	state.writeSensitive(indexer)
+	state.writeSensitive(&indexer.Indexer)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = indexer.TestOnApply
+	state.Fields = writeFreeFields(ctx, indexer.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, indexer.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var indexer *Indexer
+	// Get plan and state values
+	var indexer, prior *indexerResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -377,10 +309,20 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Update Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Fields = indexer.maskFields(request.Fields, &prior.Indexer)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, indexer, &resp.Diagnostics))
+
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, err := updateIndexer(r.auth, r.client, request, indexerResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, indexerResourceName, err, fieldValidationAttribute(indexerResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -388,10 +330,13 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	tflog.Trace(ctx, "updated "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state indexerResourceModel
 
-	state.writeSensitive(indexer)
+	state.writeSensitive(&indexer.Indexer)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = indexer.TestOnApply
+	state.Fields = writeFreeFields(ctx, indexer.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, indexer.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -417,10 +362,62 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return indexerIDByName(r.auth, r.client, name, "")
+	})
 	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
 }
 
+// testIndexer tests the indexer connection, reporting the validation failures on the related attributes.
+func testIndexer(auth context.Context, client *lidarr.APIClient, indexer *lidarr.IndexerResource, name string, diags *diag.Diagnostics) {
+	if _, err := client.IndexerAPI.TestIndexer(auth).IndexerResource(*indexer).Execute(); err != nil {
+		helpers.AddValidationDiagnostics(diags, helpers.TestConnection, name, err, fieldValidationAttribute(name, indexerFieldNames, indexer.GetFields()))
+	}
+}
+
+// createIndexer creates the indexer. When Lidarr only reports validation warnings,
+// they are reported as such and the indexer is saved anyway.
+func createIndexer(auth context.Context, client *lidarr.APIClient, indexer *lidarr.IndexerResource, name string, diags *diag.Diagnostics) (*lidarr.IndexerResource, error) {
+	response, _, err := client.IndexerAPI.CreateIndexer(auth).IndexerResource(*indexer).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Create, name, err, fieldValidationAttribute(name, indexerFieldNames, indexer.GetFields()))
+		response, _, err = client.IndexerAPI.CreateIndexer(auth).IndexerResource(*indexer).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// updateIndexer updates the indexer. When Lidarr only reports validation warnings,
+// they are reported as such and the indexer is saved anyway.
+func updateIndexer(auth context.Context, client *lidarr.APIClient, indexer *lidarr.IndexerResource, name string, diags *diag.Diagnostics) (*lidarr.IndexerResource, error) {
+	response, _, err := client.IndexerAPI.UpdateIndexer(auth, indexer.GetId()).IndexerResource(*indexer).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Update, name, err, fieldValidationAttribute(name, indexerFieldNames, indexer.GetFields()))
+		response, _, err = client.IndexerAPI.UpdateIndexer(auth, indexer.GetId()).IndexerResource(*indexer).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// freeFields validates the free-form fields against the implementation schema and returns their API representation.
+func (r *IndexerResource) freeFields(ctx context.Context, indexer *indexerResourceModel, diags *diag.Diagnostics) []lidarr.Field {
+	if !hasFreeFields(indexer.Fields, indexer.SensitiveFields) {
+		return nil
+	}
+
+	schemas, _, err := r.client.IndexerAPI.ListIndexerSchema(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, indexerResourceName+" schema", err))
+
+		return nil
+	}
+
+	fields, found := schemaFields(schemas, indexer.Implementation.ValueString())
+	validateImplementation(found, indexer.Implementation.ValueString(), diags)
+
+	return readFreeFields(ctx, indexer.Fields, indexer.SensitiveFields, fields, indexerFieldNames, diags)
+}
+
 func (i *Indexer) write(ctx context.Context, indexer *lidarr.IndexerResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -437,7 +434,7 @@ func (i *Indexer) write(ctx context.Context, indexer *lidarr.IndexerResource, di
 	i.Name = types.StringValue(indexer.GetName())
 	i.Protocol = types.StringValue(string(indexer.GetProtocol()))
 	i.Categories = types.SetValueMust(types.Int64Type, nil)
-	helpers.WriteFields(ctx, i, indexer.GetFields(), indexerFields)
+	i.writeFields(indexer.GetFields(), diags)
 }
 
 func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.IndexerResource {
@@ -452,7 +449,7 @@ func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.Ind
 	indexer.SetName(i.Name.ValueString())
 	indexer.SetProtocol(lidarr.DownloadProtocol(i.Protocol.ValueString()))
 	diags.Append(i.Tags.ElementsAs(ctx, &indexer.Tags, true)...)
-	indexer.SetFields(helpers.ReadFields(ctx, i, indexerFields))
+	indexer.SetFields(i.readFields(ctx, diags))
 
 	return indexer
 }
@@ -471,3 +468,13 @@ func (i *Indexer) writeSensitive(indexer *Indexer) {
 		i.APIKey = indexer.APIKey
 	}
 }
+
+// indexerIDByName returns the ID of the indexer with the given name, of the given implementation unless empty.
+func indexerIDByName(auth context.Context, client *lidarr.APIClient, name, implementation string) (int32, error) {
+	response, _, err := client.IndexerAPI.ListIndexer(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, indexerResourceName, err))
+	}
+
+	return helpers.FindImplementationIDByName(indexerResourceName, name, implementation, response, (*lidarr.IndexerResource).GetName, (*lidarr.IndexerResource).GetImplementation, (*lidarr.IndexerResource).GetId)
+}
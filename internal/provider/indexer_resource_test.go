@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIndexerResource(t *testing.T) {
@@ -65,3 +70,180 @@ func testAccIndexerResourceConfig(name string, priority int) string {
 		categories = [8000, 5000]
 	}`, priority, name)
 }
+
+func TestAccIndexerResourceFields(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown field
+			{
+				Config:      testAccIndexerResourceFieldsConfig("unknownField", "true"),
+				ExpectError: regexp.MustCompile("not defined by the implementation schema"),
+			},
+			// Field managed by an attribute
+			{
+				Config:      testAccIndexerResourceFieldsConfig("apiPath", "/api"),
+				ExpectError: regexp.MustCompile("managed by attribute 'api_path'"),
+			},
+			// Invalid value
+			{
+				Config:      testAccIndexerResourceFieldsConfig("rejectBlocklistedTorrentHashesWhileGrabbing", "maybe"),
+				ExpectError: regexp.MustCompile("is not a boolean"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerResourceFieldsConfig("rejectBlocklistedTorrentHashesWhileGrabbing", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "fields.rejectBlocklistedTorrentHashesWhileGrabbing", "true"),
+					resource.TestCheckResourceAttrSet("lidarr_indexer.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerResourceFieldsConfig("rejectBlocklistedTorrentHashesWhileGrabbing", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "fields.rejectBlocklistedTorrentHashesWhileGrabbing", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "lidarr_indexer.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fields"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerResourceFieldsConfig(field, value string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_indexer" "test" {
+		name = "resourceFieldsTest"
+		implementation = "Torznab"
+		protocol = "torrent"
+		config_contract = "TorznabSettings"
+		base_url = "https://feed.torznab.com"
+		api_path = "/api"
+		fields = {
+			"%s" = "%s"
+		}
+	}`, field, value)
+}
+
+func TestAccIndexerResourceTestOnApply(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Failing test with the provider default
+			{
+				Config:      testAccIndexerResourceTestOnApplyConfig("null", "invalid-url"),
+				ExpectError: regexp.MustCompile("validation failure on BaseUrl: Unable to connect"),
+			},
+			// Test disabled by the attribute
+			{
+				Config: testAccIndexerResourceTestOnApplyConfig("false", "invalid-url"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "base_url", "invalid-url"),
+				),
+			},
+			// Successful test on update
+			{
+				Config: testAccIndexerResourceTestOnApplyConfig("true", "https://feed.torznab.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "base_url", "https://feed.torznab.com"),
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "test_on_apply", "true"),
+				),
+			},
+			// Failing test on update
+			{
+				Config:      testAccIndexerResourceTestOnApplyConfig("true", "invalid-url"),
+				ExpectError: regexp.MustCompile("validation failure on BaseUrl: Unable to connect"),
+			},
+		},
+	})
+}
+
+func testAccIndexerResourceTestOnApplyConfig(testOnApply, baseURL string) string {
+	return fmt.Sprintf(`
+	provider "lidarr" {
+		test_on_apply = true
+	}
+
+	resource "lidarr_indexer" "test" {
+		name = "resourceTestOnApply"
+		implementation = "Torznab"
+		protocol = "torrent"
+		config_contract = "TorznabSettings"
+		base_url = "%s"
+		api_path = "/api"
+		test_on_apply = %s
+	}`, baseURL, testOnApply)
+}
+
+func TestAccIndexerResourceValidation(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation error
+			{
+				Config:      testAccIndexerResourceValidationConfig("", "/api"),
+				ExpectError: regexp.MustCompile(`validation failure on Name: 'Name' must not\s+be\s+empty`),
+			},
+			// Validation warning saved anyway
+			{
+				Config: testAccIndexerResourceValidationConfig("resourceValidation", "warn-path"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "api_path", "warn-path"),
+					resource.TestCheckResourceAttrSet("lidarr_indexer.test", "id"),
+				),
+			},
+			// Validation warning on update
+			{
+				Config: testAccIndexerResourceValidationConfig("resourceValidation", "warn-other"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "api_path", "warn-other"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIndexerResourceValidationConfig(name, apiPath string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_indexer" "test" {
+		name = "%s"
+		implementation = "Torznab"
+		protocol = "torrent"
+		config_contract = "TorznabSettings"
+		base_url = "https://feed.torznab.com"
+		api_path = "%s"
+	}`, name, apiPath)
+}
+
+func TestIndexerAPIUserField(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	indexer := Indexer{APIUser: types.StringValue("user")}
+	fields := indexer.readFields(context.Background(), &diags)
+	assert.False(t, diags.HasError())
+	assert.Contains(t, fields, lidarr.Field{Name: *lidarr.NewNullableString(lidarr.PtrString("apiUser")), Value: "user"})
+
+	var written Indexer
+
+	written.writeFields(fields, &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("user"), written.APIUser)
+}
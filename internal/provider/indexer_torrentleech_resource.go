@@ -35,8 +35,9 @@ func NewIndexerTorrentleechResource() resource.Resource {
 
 // IndexerTorrentleechResource defines the Torrentleech indexer implementation.
 type IndexerTorrentleechResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // IndexerTorrentleech describes the Torrentleech indexer data model.
@@ -54,6 +55,7 @@ type IndexerTorrentleech struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	TestOnApply             types.Bool    `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerTorrentleech) toIndexer() *Indexer {
@@ -131,6 +133,7 @@ func (r *IndexerTorrentleechResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerTorrentleech ID.",
 				Computed:            true,
@@ -177,6 +180,7 @@ func (r *IndexerTorrentleechResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -193,9 +197,17 @@ func (r *IndexerTorrentleechResource) Create(ctx context.Context, req resource.C
 	// Create new IndexerTorrentleech
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerTorrentleechResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, indexerTorrentleechResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentleechResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, indexerTorrentleechResourceName, err, fieldValidationAttribute(indexerTorrentleechResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -217,8 +229,15 @@ func (r *IndexerTorrentleechResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get IndexerTorrentleech current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(indexerTorrentleechResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorrentleechResourceName, err))
 
 		return
@@ -231,10 +250,11 @@ func (r *IndexerTorrentleechResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *IndexerTorrentleechResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var indexer *IndexerTorrentleech
+	// Get plan and state values
+	var indexer, prior *IndexerTorrentleech
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -242,10 +262,19 @@ func (r *IndexerTorrentleechResource) Update(ctx context.Context, req resource.U
 
 	// Update IndexerTorrentleech
 	request := indexer.read(ctx, &resp.Diagnostics)
+	request.Fields = indexer.toIndexer().maskFields(request.Fields, prior.toIndexer())
+
+	if isTestOnApply(indexer.TestOnApply, r.testOnApply) {
+		testIndexer(r.auth, r.client, request, indexerTorrentleechResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	response, err := updateIndexer(r.auth, r.client, request, indexerTorrentleechResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorrentleechResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, indexerTorrentleechResourceName, err, fieldValidationAttribute(indexerTorrentleechResourceName, indexerFieldNames, request.GetFields()))
 
 		return
 	}
@@ -278,7 +307,9 @@ func (r *IndexerTorrentleechResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *IndexerTorrentleechResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return indexerIDByName(r.auth, r.client, name, indexerTorrentleechImplementation)
+	})
 	tflog.Trace(ctx, "imported "+indexerTorrentleechResourceName+": "+req.ID)
 }
 
@@ -102,7 +102,7 @@ func (r *MetadataConfigResource) Create(ctx context.Context, req resource.Create
 	// Create new MetadataConfig
 	response, _, err := r.client.MetadataProviderConfigAPI.UpdateMetadataProviderConfig(r.auth, strconv.Itoa(int(request.GetId()))).MetadataProviderConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataConfigResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, metadataConfigResourceName, err, validationAttribute(metadataConfigResourceName))
 
 		return
 	}
@@ -153,7 +153,7 @@ func (r *MetadataConfigResource) Update(ctx context.Context, req resource.Update
 	// Update MetadataConfig
 	response, _, err := r.client.MetadataProviderConfigAPI.UpdateMetadataProviderConfig(r.auth, strconv.Itoa(int(request.GetId()))).MetadataProviderConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataConfigResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, metadataConfigResourceName, err, validationAttribute(metadataConfigResourceName))
 
 		return
 	}
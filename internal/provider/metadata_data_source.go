@@ -35,7 +35,7 @@ func (d *MetadataDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nSingle [Metadata](../resources/metadata).",
-		Attributes: map[string]schema.Attribute{
+		Attributes: withMetadataFieldDataSourceAttributes(map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Computed:            true,
@@ -61,28 +61,7 @@ func (d *MetadataDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
 			},
-			// Field values
-			"track_metadata": schema.BoolAttribute{
-				MarkdownDescription: "Track metadata flag.",
-				Computed:            true,
-			},
-			"album_images": schema.BoolAttribute{
-				MarkdownDescription: "Album images flag.",
-				Computed:            true,
-			},
-			"artist_images": schema.BoolAttribute{
-				MarkdownDescription: "Artist images flag.",
-				Computed:            true,
-			},
-			"artist_metadata": schema.BoolAttribute{
-				MarkdownDescription: "Artist metadata flag.",
-				Computed:            true,
-			},
-			"album_metadata": schema.BoolAttribute{
-				MarkdownDescription: "Album metadata flag.",
-				Computed:            true,
-			},
-		},
+		}),
 	}
 }
 
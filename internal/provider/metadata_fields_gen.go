@@ -0,0 +1,116 @@
+// Code generated by fieldgen from fieldspecs/metadata.json; DO NOT EDIT.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// writeFields populates the Metadata attributes from the API fields.
+func (m *Metadata) writeFields(fields []lidarr.Field, diags *diag.Diagnostics) {
+	for _, field := range fields {
+		var err error
+
+		switch field.GetName() {
+		case "trackMetadata":
+			m.TrackMetadata, err = helpers.BoolFieldValue(&field)
+		case "albumImages":
+			m.AlbumImages, err = helpers.BoolFieldValue(&field)
+		case "artistImages":
+			m.ArtistImages, err = helpers.BoolFieldValue(&field)
+		case "artistMetadata":
+			m.ArtistMetadata, err = helpers.BoolFieldValue(&field)
+		case "albumMetadata":
+			m.AlbumMetadata, err = helpers.BoolFieldValue(&field)
+		}
+
+		if err != nil {
+			diags.AddError(helpers.FieldError, helpers.ParseFieldError(field.GetName(), err))
+		}
+	}
+}
+
+// readFields returns the API fields of the Metadata attributes.
+func (m *Metadata) readFields(_ context.Context, _ *diag.Diagnostics) []lidarr.Field {
+	var fields []lidarr.Field
+
+	fields = helpers.AppendBoolField(fields, "trackMetadata", m.TrackMetadata)
+	fields = helpers.AppendBoolField(fields, "albumImages", m.AlbumImages)
+	fields = helpers.AppendBoolField(fields, "artistImages", m.ArtistImages)
+	fields = helpers.AppendBoolField(fields, "artistMetadata", m.ArtistMetadata)
+	fields = helpers.AppendBoolField(fields, "albumMetadata", m.AlbumMetadata)
+
+	return fields
+}
+
+// withMetadataFieldAttributes adds the field attributes to the Metadata resource schema.
+func withMetadataFieldAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["track_metadata"] = schema.BoolAttribute{
+		MarkdownDescription: "Track metadata flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["album_images"] = schema.BoolAttribute{
+		MarkdownDescription: "Album images flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["artist_images"] = schema.BoolAttribute{
+		MarkdownDescription: "Artist images flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["artist_metadata"] = schema.BoolAttribute{
+		MarkdownDescription: "Artist metadata flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["album_metadata"] = schema.BoolAttribute{
+		MarkdownDescription: "Album metadata flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	return attributes
+}
+
+// withMetadataFieldDataSourceAttributes adds the field attributes to the Metadata data source schemas.
+func withMetadataFieldDataSourceAttributes(attributes map[string]dataSourceSchema.Attribute) map[string]dataSourceSchema.Attribute {
+	attributes["track_metadata"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Track metadata flag.",
+		Computed:            true,
+	}
+	attributes["album_images"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Album images flag.",
+		Computed:            true,
+	}
+	attributes["artist_images"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Artist images flag.",
+		Computed:            true,
+	}
+	attributes["artist_metadata"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Artist metadata flag.",
+		Computed:            true,
+	}
+	attributes["album_metadata"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Album metadata flag.",
+		Computed:            true,
+	}
+
+	return attributes
+}
+
+// metadataFieldNames maps the API field names to the Metadata attributes managing them.
+var metadataFieldNames = map[string]string{
+	"trackMetadata":  "track_metadata",
+	"albumImages":    "album_images",
+	"artistImages":   "artist_images",
+	"artistMetadata": "artist_metadata",
+	"albumMetadata":  "album_metadata",
+}
@@ -147,9 +147,9 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, err := createMetadata(r.auth, r.client, request, metadataKodiResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataKodiResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, metadataKodiResourceName, err, fieldValidationAttribute(metadataKodiResourceName, metadataFieldNames, request.GetFields()))
 
 		return
 	}
@@ -171,8 +171,15 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataKodi current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(metadataKodiResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataKodiResourceName, err))
 
 		return
@@ -197,9 +204,9 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	response, err := updateMetadata(r.auth, r.client, request, metadataKodiResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataKodiResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, metadataKodiResourceName, err, fieldValidationAttribute(metadataKodiResourceName, metadataFieldNames, request.GetFields()))
 
 		return
 	}
@@ -232,7 +239,9 @@ func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *MetadataKodiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return metadataIDByName(r.auth, r.client, name, metadataKodiImplementation)
+	})
 	tflog.Trace(ctx, "imported "+metadataKodiResourceName+": "+req.ID)
 }
 
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
@@ -135,7 +136,7 @@ func (r *MetadataProfileResource) Create(ctx context.Context, req resource.Creat
 
 	response, _, err := r.client.MetadataProfileAPI.CreateMetadataProfile(r.auth).MetadataProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataProfileResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, metadataProfileResourceName, err, validationAttribute(metadataProfileResourceName))
 
 		return
 	}
@@ -157,8 +158,15 @@ func (r *MetadataProfileResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get metadataProfile current value
-	response, _, err := r.client.MetadataProfileAPI.GetMetadataProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataProfileAPI.GetMetadataProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(metadataProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataProfileResourceName, err))
 
 		return
@@ -185,7 +193,7 @@ func (r *MetadataProfileResource) Update(ctx context.Context, req resource.Updat
 
 	response, _, err := r.client.MetadataProfileAPI.UpdateMetadataProfile(r.auth, strconv.Itoa(int(request.GetId()))).MetadataProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataProfileResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, metadataProfileResourceName, err, validationAttribute(metadataProfileResourceName))
 
 		return
 	}
@@ -218,7 +226,9 @@ func (r *MetadataProfileResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *MetadataProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return metadataProfileIDByName(r.auth, r.client, name)
+	})
 	tflog.Trace(ctx, "imported "+metadataProfileResourceName+": "+req.ID)
 }
 
@@ -300,3 +310,13 @@ func (p *MetadataProfile) read(ctx context.Context, diags *diag.Diagnostics) *li
 
 	return profile
 }
+
+// metadataProfileIDByName returns the ID of the metadata profile with the given name.
+func metadataProfileIDByName(auth context.Context, client *lidarr.APIClient, name string) (int32, error) {
+	response, _, err := client.MetadataProfileAPI.ListMetadataProfile(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, metadataProfileResourceName, err))
+	}
+
+	return helpers.FindIDByName(metadataProfileResourceName, "name", name, response, (*lidarr.MetadataProfileResource).GetName, (*lidarr.MetadataProfileResource).GetId)
+}
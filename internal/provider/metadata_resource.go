@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
@@ -23,11 +24,10 @@ const metadataResourceName = "metadata"
 var (
 	_ resource.Resource                = &MetadataResource{}
 	_ resource.ResourceWithImportState = &MetadataResource{}
+	_ resource.ResourceWithModifyPlan  = &MetadataResource{}
 )
 
-var metadataFields = helpers.Fields{
-	Bools: []string{"artistMetadata", "albumMetadata", "artistImages", "albumImages", "trackMetadata"},
-}
+//go:generate go run ../../tools/fieldgen -spec fieldspecs/metadata.json -out metadata_fields_gen.go
 
 func NewMetadataResource() resource.Resource {
 	return &MetadataResource{}
@@ -54,6 +54,13 @@ type Metadata struct {
 	TrackMetadata  types.Bool   `tfsdk:"track_metadata"`
 }
 
+// metadataResourceModel describes the generic metadata resource data model.
+type metadataResourceModel struct {
+	Fields          types.Map `tfsdk:"fields"`
+	SensitiveFields types.Map `tfsdk:"sensitive_fields"`
+	Metadata
+}
+
 func (m Metadata) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -78,7 +85,7 @@ func (r *MetadataResource) Metadata(_ context.Context, req resource.MetadataRequ
 func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nGeneric Metadata resource. When possible use a specific resource instead.\nFor more information refer to [Metadata](https://wiki.servarr.com/lidarr/settings#metadata) documentation.",
-		Attributes: map[string]schema.Attribute{
+		Attributes: withMetadataFieldAttributes(map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -102,6 +109,8 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"fields":           fieldsAttribute(),
+			"sensitive_fields": sensitiveFieldsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -109,33 +118,7 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			// Field values
-			"track_metadata": schema.BoolAttribute{
-				MarkdownDescription: "Track metadata flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"album_images": schema.BoolAttribute{
-				MarkdownDescription: "Album images flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"artist_images": schema.BoolAttribute{
-				MarkdownDescription: "Artist images flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"artist_metadata": schema.BoolAttribute{
-				MarkdownDescription: "Artist metadata flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"album_metadata": schema.BoolAttribute{
-				MarkdownDescription: "Album metadata flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-		},
+		}),
 	}
 }
 
@@ -146,9 +129,26 @@ func (r *MetadataResource) Configure(ctx context.Context, req resource.Configure
 	}
 }
 
+func (r *MetadataResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or if provider is not configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var metadata *metadataResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
+
+	if resp.Diagnostics.HasError() || metadata.Implementation.IsUnknown() {
+		return
+	}
+
+	r.freeFields(ctx, metadata, &resp.Diagnostics)
+}
+
 func (r *MetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var metadata *Metadata
+	var metadata *metadataResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
 
@@ -158,10 +158,15 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Create new Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, metadata, &resp.Diagnostics))
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createMetadata(r.auth, r.client, request, metadataResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, metadataResourceName, err, fieldValidationAttribute(metadataResourceName, metadataFieldNames, request.GetFields()))
 
 		return
 	}
@@ -169,15 +174,17 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 	tflog.Trace(ctx, "created "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	var state metadataResourceModel
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.Fields = writeFreeFields(ctx, metadata.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, metadata.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var metadata *Metadata
+	var metadata *metadataResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &metadata)...)
 
@@ -186,8 +193,15 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Get Metadata current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(metadataResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataResourceName, err))
 
 		return
@@ -196,15 +210,17 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	tflog.Trace(ctx, "read "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	var state metadataResourceModel
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.Fields = writeFreeFields(ctx, metadata.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, metadata.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var metadata *Metadata
+	var metadata *metadataResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
 
@@ -214,10 +230,15 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Update Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, metadata, &resp.Diagnostics))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	response, err := updateMetadata(r.auth, r.client, request, metadataResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, metadataResourceName, err, fieldValidationAttribute(metadataResourceName, metadataFieldNames, request.GetFields()))
 
 		return
 	}
@@ -225,9 +246,11 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Trace(ctx, "updated "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	var state metadataResourceModel
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.Fields = writeFreeFields(ctx, metadata.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, metadata.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -253,10 +276,55 @@ func (r *MetadataResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *MetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return metadataIDByName(r.auth, r.client, name, "")
+	})
 	tflog.Trace(ctx, "imported "+metadataResourceName+": "+req.ID)
 }
 
+// createMetadata creates the metadata. When Lidarr only reports validation warnings,
+// they are reported as such and the metadata is saved anyway.
+func createMetadata(auth context.Context, client *lidarr.APIClient, metadata *lidarr.MetadataResource, name string, diags *diag.Diagnostics) (*lidarr.MetadataResource, error) {
+	response, _, err := client.MetadataAPI.CreateMetadata(auth).MetadataResource(*metadata).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Create, name, err, fieldValidationAttribute(name, metadataFieldNames, metadata.GetFields()))
+		response, _, err = client.MetadataAPI.CreateMetadata(auth).MetadataResource(*metadata).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// updateMetadata updates the metadata. When Lidarr only reports validation warnings,
+// they are reported as such and the metadata is saved anyway.
+func updateMetadata(auth context.Context, client *lidarr.APIClient, metadata *lidarr.MetadataResource, name string, diags *diag.Diagnostics) (*lidarr.MetadataResource, error) {
+	response, _, err := client.MetadataAPI.UpdateMetadata(auth, metadata.GetId()).MetadataResource(*metadata).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Update, name, err, fieldValidationAttribute(name, metadataFieldNames, metadata.GetFields()))
+		response, _, err = client.MetadataAPI.UpdateMetadata(auth, metadata.GetId()).MetadataResource(*metadata).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// freeFields validates the free-form fields against the implementation schema and returns their API representation.
+func (r *MetadataResource) freeFields(ctx context.Context, metadata *metadataResourceModel, diags *diag.Diagnostics) []lidarr.Field {
+	if !hasFreeFields(metadata.Fields, metadata.SensitiveFields) {
+		return nil
+	}
+
+	schemas, _, err := r.client.MetadataAPI.ListMetadataSchema(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, metadataResourceName+" schema", err))
+
+		return nil
+	}
+
+	fields, found := schemaFields(schemas, metadata.Implementation.ValueString())
+	validateImplementation(found, metadata.Implementation.ValueString(), diags)
+
+	return readFreeFields(ctx, metadata.Fields, metadata.SensitiveFields, fields, metadataFieldNames, diags)
+}
+
 func (m *Metadata) write(ctx context.Context, metadata *lidarr.MetadataResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -267,7 +335,7 @@ func (m *Metadata) write(ctx context.Context, metadata *lidarr.MetadataResource,
 	m.Name = types.StringValue(metadata.GetName())
 	m.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, metadata.Tags)
 	diags.Append(localDiag...)
-	helpers.WriteFields(ctx, m, metadata.GetFields(), metadataFields)
+	m.writeFields(metadata.GetFields(), diags)
 }
 
 func (m *Metadata) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.MetadataResource {
@@ -278,7 +346,17 @@ func (m *Metadata) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.Me
 	metadata.SetImplementation(m.Implementation.ValueString())
 	metadata.SetName(m.Name.ValueString())
 	diags.Append(m.Tags.ElementsAs(ctx, &metadata.Tags, true)...)
-	metadata.SetFields(helpers.ReadFields(ctx, m, metadataFields))
+	metadata.SetFields(m.readFields(ctx, diags))
 
 	return metadata
 }
+
+// metadataIDByName returns the ID of the metadata with the given name, of the given implementation unless empty.
+func metadataIDByName(auth context.Context, client *lidarr.APIClient, name, implementation string) (int32, error) {
+	response, _, err := client.MetadataAPI.ListMetadata(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, metadataResourceName, err))
+	}
+
+	return helpers.FindImplementationIDByName(metadataResourceName, name, implementation, response, (*lidarr.MetadataResource).GetName, (*lidarr.MetadataResource).GetImplementation, (*lidarr.MetadataResource).GetId)
+}
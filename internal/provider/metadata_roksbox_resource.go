@@ -140,9 +140,9 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 	// Create new MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, err := createMetadata(r.auth, r.client, request, metadataRoksboxResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataRoksboxResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, metadataRoksboxResourceName, err, fieldValidationAttribute(metadataRoksboxResourceName, metadataFieldNames, request.GetFields()))
 
 		return
 	}
@@ -164,8 +164,15 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get MetadataRoksbox current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(metadataRoksboxResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataRoksboxResourceName, err))
 
 		return
@@ -190,9 +197,9 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 	// Update MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	response, err := updateMetadata(r.auth, r.client, request, metadataRoksboxResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataRoksboxResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, metadataRoksboxResourceName, err, fieldValidationAttribute(metadataRoksboxResourceName, metadataFieldNames, request.GetFields()))
 
 		return
 	}
@@ -225,7 +232,9 @@ func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *MetadataRoksboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return metadataIDByName(r.auth, r.client, name, metadataRoksboxImplementation)
+	})
 	tflog.Trace(ctx, "imported "+metadataRoksboxResourceName+": "+req.ID)
 }
 
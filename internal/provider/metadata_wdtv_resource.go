@@ -126,9 +126,9 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, err := createMetadata(r.auth, r.client, request, metadataWdtvResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataWdtvResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, metadataWdtvResourceName, err, fieldValidationAttribute(metadataWdtvResourceName, metadataFieldNames, request.GetFields()))
 
 		return
 	}
@@ -150,8 +150,15 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataWdtv current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(metadataWdtvResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataWdtvResourceName, err))
 
 		return
@@ -176,9 +183,9 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	response, err := updateMetadata(r.auth, r.client, request, metadataWdtvResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataWdtvResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, metadataWdtvResourceName, err, fieldValidationAttribute(metadataWdtvResourceName, metadataFieldNames, request.GetFields()))
 
 		return
 	}
@@ -211,7 +218,9 @@ func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *MetadataWdtvResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return metadataIDByName(r.auth, r.client, name, metadataWdtvImplementation)
+	})
 	tflog.Trace(ctx, "imported "+metadataWdtvResourceName+": "+req.ID)
 }
 
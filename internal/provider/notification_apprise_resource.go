@@ -28,6 +28,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationAppriseResource{}
 	_ resource.ResourceWithImportState = &NotificationAppriseResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationAppriseResource{}
 )
 
 func NewNotificationAppriseResource() resource.Resource {
@@ -36,8 +37,10 @@ func NewNotificationAppriseResource() resource.Resource {
 
 // NotificationAppriseResource defines the notification implementation.
 type NotificationAppriseResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
+	data        *LidarrData
 }
 
 // NotificationApprise describes the notification data model.
@@ -63,6 +66,7 @@ type NotificationApprise struct {
 	OnDownloadFailure     types.Bool   `tfsdk:"on_download_failure"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
 	OnImportFailure       types.Bool   `tfsdk:"on_import_failure"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationApprise) toNotification() *Notification {
@@ -190,6 +194,7 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -246,9 +251,15 @@ func (r *NotificationAppriseResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
+		r.data = resourceLidarrData(req)
 	}
 }
 
+func (r *NotificationAppriseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkVersionRequirements(ctx, req.Config, r.data, notificationVersionRequirements, &resp.Diagnostics)
+}
+
 func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationApprise
@@ -262,9 +273,17 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 	// Create new NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationAppriseResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, notificationAppriseResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationAppriseResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, notificationAppriseResourceName, err, fieldValidationAttribute(notificationAppriseResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -286,8 +305,15 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationApprise current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(notificationAppriseResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationAppriseResourceName, err))
 
 		return
@@ -300,10 +326,11 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var notification *NotificationApprise
+	// Get plan and state values
+	var notification, prior *NotificationApprise
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -311,10 +338,19 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 
 	// Update NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Fields = notification.toNotification().maskFields(request.Fields, prior.toNotification())
+
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationAppriseResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, err := updateNotification(r.auth, r.client, request, notificationAppriseResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationAppriseResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, notificationAppriseResourceName, err, fieldValidationAttribute(notificationAppriseResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -347,7 +383,9 @@ func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationAppriseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return notificationIDByName(r.auth, r.client, name, notificationAppriseImplementation)
+	})
 	tflog.Trace(ctx, "imported "+notificationAppriseResourceName+": "+req.ID)
 }
 
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmailResource{}
 	_ resource.ResourceWithImportState = &NotificationEmailResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmailResource{}
 )
 
 func NewNotificationEmailResource() resource.Resource {
@@ -34,8 +35,10 @@ func NewNotificationEmailResource() resource.Resource {
 
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
+	data        *LidarrData
 }
 
 // NotificationEmail describes the notification data model.
@@ -63,6 +66,7 @@ type NotificationEmail struct {
 	OnDownloadFailure     types.Bool   `tfsdk:"on_download_failure"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
 	OnImportFailure       types.Bool   `tfsdk:"on_import_failure"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationEmail) toNotification() *Notification {
@@ -194,6 +198,7 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -256,9 +261,15 @@ func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
+		r.data = resourceLidarrData(req)
 	}
 }
 
+func (r *NotificationEmailResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkVersionRequirements(ctx, req.Config, r.data, notificationVersionRequirements, &resp.Diagnostics)
+}
+
 func (r *NotificationEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationEmail
@@ -272,9 +283,17 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 	// Create new NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationEmailResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, notificationEmailResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, notificationEmailResourceName, err, fieldValidationAttribute(notificationEmailResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -296,8 +315,15 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationEmail current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(notificationEmailResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmailResourceName, err))
 
 		return
@@ -310,10 +336,11 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *NotificationEmailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var notification *NotificationEmail
+	// Get plan and state values
+	var notification, prior *NotificationEmail
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -321,10 +348,19 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Fields = notification.toNotification().maskFields(request.Fields, prior.toNotification())
+
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationEmailResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, err := updateNotification(r.auth, r.client, request, notificationEmailResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, notificationEmailResourceName, err, fieldValidationAttribute(notificationEmailResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -357,7 +393,9 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return notificationIDByName(r.auth, r.client, name, notificationEmailImplementation)
+	})
 	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
 }
 
@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmbyResource{}
 	_ resource.ResourceWithImportState = &NotificationEmbyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmbyResource{}
 )
 
 func NewNotificationEmbyResource() resource.Resource {
@@ -34,8 +35,10 @@ func NewNotificationEmbyResource() resource.Resource {
 
 // NotificationEmbyResource defines the notification implementation.
 type NotificationEmbyResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
+	data        *LidarrData
 }
 
 // NotificationEmby describes the notification data model.
@@ -60,6 +63,7 @@ type NotificationEmby struct {
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationEmby) toNotification() *Notification {
@@ -185,6 +189,7 @@ func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -230,9 +235,15 @@ func (r *NotificationEmbyResource) Configure(ctx context.Context, req resource.C
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
+		r.data = resourceLidarrData(req)
 	}
 }
 
+func (r *NotificationEmbyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkVersionRequirements(ctx, req.Config, r.data, notificationVersionRequirements, &resp.Diagnostics)
+}
+
 func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationEmby
@@ -246,9 +257,17 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 	// Create new NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationEmbyResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, notificationEmbyResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmbyResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, notificationEmbyResourceName, err, fieldValidationAttribute(notificationEmbyResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -270,8 +289,15 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationEmby current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(notificationEmbyResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmbyResourceName, err))
 
 		return
@@ -284,10 +310,11 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var notification *NotificationEmby
+	// Get plan and state values
+	var notification, prior *NotificationEmby
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -295,10 +322,19 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 
 	// Update NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Fields = notification.toNotification().maskFields(request.Fields, prior.toNotification())
+
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationEmbyResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, err := updateNotification(r.auth, r.client, request, notificationEmbyResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmbyResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, notificationEmbyResourceName, err, fieldValidationAttribute(notificationEmbyResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -331,7 +367,9 @@ func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationEmbyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return notificationIDByName(r.auth, r.client, name, notificationEmbyImplementation)
+	})
 	tflog.Trace(ctx, "imported "+notificationEmbyResourceName+": "+req.ID)
 }
 
@@ -0,0 +1,1061 @@
+// Code generated by fieldgen from fieldspecs/notification.json; DO NOT EDIT.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// writeFields populates the Notification attributes from the API fields.
+func (n *Notification) writeFields(fields []lidarr.Field, diags *diag.Diagnostics) {
+	for _, field := range fields {
+		var err error
+
+		switch field.GetName() {
+		case "alwaysUpdate":
+			n.AlwaysUpdate, err = helpers.BoolFieldValue(&field)
+		case "cleanLibrary":
+			n.CleanLibrary, err = helpers.BoolFieldValue(&field)
+		case "directMessage":
+			n.DirectMessage, err = helpers.BoolFieldValue(&field)
+		case "notify":
+			n.Notify, err = helpers.BoolFieldValue(&field)
+		case "requireEncryption":
+			n.RequireEncryption, err = helpers.BoolFieldValue(&field)
+		case "sendSilently":
+			n.SendSilently, err = helpers.BoolFieldValue(&field)
+		case "updateLibrary":
+			n.UpdateLibrary, err = helpers.BoolFieldValue(&field)
+		case "useEuEndpoint":
+			n.UseEuEndpoint, err = helpers.BoolFieldValue(&field)
+		case "useSsl":
+			n.UseSSL, err = helpers.BoolFieldValue(&field)
+		case "port":
+			n.Port, err = helpers.Int64FieldValue(&field)
+		case "method":
+			n.Method, err = helpers.Int64FieldValue(&field)
+		case "priority":
+			n.Priority, err = helpers.Int64FieldValue(&field)
+		case "notificationType":
+			n.NotificationType, err = helpers.Int64FieldValue(&field)
+		case "retry":
+			n.Retry, err = helpers.Int64FieldValue(&field)
+		case "expire":
+			n.Expire, err = helpers.Int64FieldValue(&field)
+		case "accessToken":
+			n.AccessToken, err = helpers.SensitiveFieldValue(&field, n.AccessToken)
+		case "accessTokenSecret":
+			n.AccessTokenSecret, err = helpers.SensitiveFieldValue(&field, n.AccessTokenSecret)
+		case "apiKey", "aPIKey":
+			n.APIKey, err = helpers.SensitiveFieldValue(&field, n.APIKey)
+		case "appToken":
+			n.AppToken, err = helpers.SensitiveFieldValue(&field, n.AppToken)
+		case "arguments":
+			n.Arguments, err = helpers.StringFieldValue(&field, n.Arguments)
+		case "author":
+			n.Author, err = helpers.StringFieldValue(&field, n.Author)
+		case "authToken":
+			n.AuthToken, err = helpers.SensitiveFieldValue(&field, n.AuthToken)
+		case "authUser":
+			n.AuthUser, err = helpers.StringFieldValue(&field, n.AuthUser)
+		case "serverUrl":
+			n.ServerURL, err = helpers.StringFieldValue(&field, n.ServerURL)
+		case "statelessUrls":
+			n.StatelessURLs, err = helpers.StringFieldValue(&field, n.StatelessURLs)
+		case "configurationKey":
+			n.ConfigurationKey, err = helpers.SensitiveFieldValue(&field, n.ConfigurationKey)
+		case "authUsername":
+			n.AuthUsername, err = helpers.StringFieldValue(&field, n.AuthUsername)
+		case "authPassword":
+			n.AuthPassword, err = helpers.SensitiveFieldValue(&field, n.AuthPassword)
+		case "avatar":
+			n.Avatar, err = helpers.StringFieldValue(&field, n.Avatar)
+		case "botToken":
+			n.BotToken, err = helpers.SensitiveFieldValue(&field, n.BotToken)
+		case "channel":
+			n.Channel, err = helpers.StringFieldValue(&field, n.Channel)
+		case "chatId":
+			n.ChatID, err = helpers.StringFieldValue(&field, n.ChatID)
+		case "consumerKey":
+			n.ConsumerKey, err = helpers.SensitiveFieldValue(&field, n.ConsumerKey)
+		case "consumerSecret":
+			n.ConsumerSecret, err = helpers.SensitiveFieldValue(&field, n.ConsumerSecret)
+		case "deviceNames":
+			n.DeviceNames, err = helpers.StringFieldValue(&field, n.DeviceNames)
+		case "displayTime":
+			n.DisplayTime, err = helpers.Int64FieldValue(&field)
+		case "expires":
+			n.Expires, err = helpers.StringFieldValue(&field, n.Expires)
+		case "event":
+			n.Event, err = helpers.StringFieldValue(&field, n.Event)
+		case "key":
+			n.Key, err = helpers.SensitiveFieldValue(&field, n.Key)
+		case "from":
+			n.From, err = helpers.StringFieldValue(&field, n.From)
+		case "host":
+			n.Host, err = helpers.StringFieldValue(&field, n.Host)
+		case "icon":
+			n.Icon, err = helpers.StringFieldValue(&field, n.Icon)
+		case "mention":
+			n.Mention, err = helpers.StringFieldValue(&field, n.Mention)
+		case "password":
+			n.Password, err = helpers.SensitiveFieldValue(&field, n.Password)
+		case "path":
+			n.Path, err = helpers.StringFieldValue(&field, n.Path)
+		case "refreshToken":
+			n.RefreshToken, err = helpers.StringFieldValue(&field, n.RefreshToken)
+		case "senderDomain":
+			n.SenderDomain, err = helpers.StringFieldValue(&field, n.SenderDomain)
+		case "senderId":
+			n.SenderID, err = helpers.StringFieldValue(&field, n.SenderID)
+		case "senderNumber":
+			n.SenderNumber, err = helpers.SensitiveFieldValue(&field, n.SenderNumber)
+		case "receiverId":
+			n.ReceiverID, err = helpers.StringFieldValue(&field, n.ReceiverID)
+		case "server":
+			n.Server, err = helpers.StringFieldValue(&field, n.Server)
+		case "signIn":
+			n.SignIn, err = helpers.StringFieldValue(&field, n.SignIn)
+		case "sound":
+			n.Sound, err = helpers.StringFieldValue(&field, n.Sound)
+		case "token":
+			n.Token, err = helpers.StringFieldValue(&field, n.Token)
+		case "url":
+			n.URL, err = helpers.StringFieldValue(&field, n.URL)
+		case "urlBase":
+			n.URLBase, err = helpers.StringFieldValue(&field, n.URLBase)
+		case "clickUrl":
+			n.ClickURL, err = helpers.StringFieldValue(&field, n.ClickURL)
+		case "userKey":
+			n.UserKey, err = helpers.SensitiveFieldValue(&field, n.UserKey)
+		case "username", "userName":
+			n.Username, err = helpers.StringFieldValue(&field, n.Username)
+		case "webHookUrl":
+			n.WebHookURL, err = helpers.StringFieldValue(&field, n.WebHookURL)
+		case "channelTags":
+			n.ChannelTags, err = helpers.StringSetFieldValue(&field)
+		case "deviceIds":
+			n.DeviceIDs, err = helpers.StringSetFieldValue(&field)
+		case "devices":
+			n.Devices, err = helpers.StringSetFieldValue(&field)
+		case "recipients":
+			n.Recipients, err = helpers.StringSetFieldValue(&field)
+		case "tags":
+			n.FieldTags, err = helpers.StringSetFieldValue(&field)
+		case "grabFields":
+			n.GrabFields, err = helpers.Int64SetFieldValue(&field)
+		case "importFields":
+			n.ImportFields, err = helpers.Int64SetFieldValue(&field)
+		case "to":
+			n.To, err = helpers.StringSetFieldValue(&field)
+		case "cC":
+			n.Cc, err = helpers.StringSetFieldValue(&field)
+		case "bcc":
+			n.Bcc, err = helpers.StringSetFieldValue(&field)
+		case "topics":
+			n.Topics, err = helpers.StringSetFieldValue(&field)
+		}
+
+		if err != nil {
+			diags.AddError(helpers.FieldError, helpers.ParseFieldError(field.GetName(), err))
+		}
+	}
+}
+
+// readFields returns the API fields of the Notification attributes.
+func (n *Notification) readFields(ctx context.Context, diags *diag.Diagnostics) []lidarr.Field {
+	var fields []lidarr.Field
+
+	fields = helpers.AppendBoolField(fields, "alwaysUpdate", n.AlwaysUpdate)
+	fields = helpers.AppendBoolField(fields, "cleanLibrary", n.CleanLibrary)
+	fields = helpers.AppendBoolField(fields, "directMessage", n.DirectMessage)
+	fields = helpers.AppendBoolField(fields, "notify", n.Notify)
+	fields = helpers.AppendBoolField(fields, "requireEncryption", n.RequireEncryption)
+	fields = helpers.AppendBoolField(fields, "sendSilently", n.SendSilently)
+	fields = helpers.AppendBoolField(fields, "updateLibrary", n.UpdateLibrary)
+	fields = helpers.AppendBoolField(fields, "useEuEndpoint", n.UseEuEndpoint)
+	fields = helpers.AppendBoolField(fields, "useSsl", n.UseSSL)
+	fields = helpers.AppendInt64Field(fields, "port", n.Port)
+	fields = helpers.AppendInt64Field(fields, "method", n.Method)
+	fields = helpers.AppendInt64Field(fields, "priority", n.Priority)
+	fields = helpers.AppendInt64Field(fields, "notificationType", n.NotificationType)
+	fields = helpers.AppendInt64Field(fields, "retry", n.Retry)
+	fields = helpers.AppendInt64Field(fields, "expire", n.Expire)
+	fields = helpers.AppendSensitiveField(fields, "accessToken", n.AccessToken)
+	fields = helpers.AppendSensitiveField(fields, "accessTokenSecret", n.AccessTokenSecret)
+	fields = helpers.AppendSensitiveField(fields, "apiKey", n.APIKey)
+	fields = helpers.AppendSensitiveField(fields, "aPIKey", n.APIKey)
+	fields = helpers.AppendSensitiveField(fields, "appToken", n.AppToken)
+	fields = helpers.AppendStringField(fields, "arguments", n.Arguments)
+	fields = helpers.AppendStringField(fields, "author", n.Author)
+	fields = helpers.AppendSensitiveField(fields, "authToken", n.AuthToken)
+	fields = helpers.AppendStringField(fields, "authUser", n.AuthUser)
+	fields = helpers.AppendStringField(fields, "serverUrl", n.ServerURL)
+	fields = helpers.AppendStringField(fields, "statelessUrls", n.StatelessURLs)
+	fields = helpers.AppendSensitiveField(fields, "configurationKey", n.ConfigurationKey)
+	fields = helpers.AppendStringField(fields, "authUsername", n.AuthUsername)
+	fields = helpers.AppendSensitiveField(fields, "authPassword", n.AuthPassword)
+	fields = helpers.AppendStringField(fields, "avatar", n.Avatar)
+	fields = helpers.AppendSensitiveField(fields, "botToken", n.BotToken)
+	fields = helpers.AppendStringField(fields, "channel", n.Channel)
+	fields = helpers.AppendStringField(fields, "chatId", n.ChatID)
+	fields = helpers.AppendSensitiveField(fields, "consumerKey", n.ConsumerKey)
+	fields = helpers.AppendSensitiveField(fields, "consumerSecret", n.ConsumerSecret)
+	fields = helpers.AppendStringField(fields, "deviceNames", n.DeviceNames)
+	fields = helpers.AppendInt64Field(fields, "displayTime", n.DisplayTime)
+	fields = helpers.AppendStringField(fields, "expires", n.Expires)
+	fields = helpers.AppendStringField(fields, "event", n.Event)
+	fields = helpers.AppendSensitiveField(fields, "key", n.Key)
+	fields = helpers.AppendStringField(fields, "from", n.From)
+	fields = helpers.AppendStringField(fields, "host", n.Host)
+	fields = helpers.AppendStringField(fields, "icon", n.Icon)
+	fields = helpers.AppendStringField(fields, "mention", n.Mention)
+	fields = helpers.AppendSensitiveField(fields, "password", n.Password)
+	fields = helpers.AppendStringField(fields, "path", n.Path)
+	fields = helpers.AppendStringField(fields, "refreshToken", n.RefreshToken)
+	fields = helpers.AppendStringField(fields, "senderDomain", n.SenderDomain)
+	fields = helpers.AppendStringField(fields, "senderId", n.SenderID)
+	fields = helpers.AppendSensitiveField(fields, "senderNumber", n.SenderNumber)
+	fields = helpers.AppendStringField(fields, "receiverId", n.ReceiverID)
+	fields = helpers.AppendStringField(fields, "server", n.Server)
+	fields = helpers.AppendStringField(fields, "signIn", n.SignIn)
+	fields = helpers.AppendStringField(fields, "sound", n.Sound)
+	fields = helpers.AppendStringField(fields, "token", n.Token)
+	fields = helpers.AppendStringField(fields, "url", n.URL)
+	fields = helpers.AppendStringField(fields, "urlBase", n.URLBase)
+	fields = helpers.AppendStringField(fields, "clickUrl", n.ClickURL)
+	fields = helpers.AppendSensitiveField(fields, "userKey", n.UserKey)
+	fields = helpers.AppendStringField(fields, "username", n.Username)
+	fields = helpers.AppendStringField(fields, "userName", n.Username)
+	fields = helpers.AppendStringField(fields, "webHookUrl", n.WebHookURL)
+	fields = helpers.AppendStringSetField(ctx, fields, "channelTags", n.ChannelTags, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "deviceIds", n.DeviceIDs, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "devices", n.Devices, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "recipients", n.Recipients, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "tags", n.FieldTags, diags)
+	fields = helpers.AppendInt64SetField(ctx, fields, "grabFields", n.GrabFields, diags)
+	fields = helpers.AppendInt64SetField(ctx, fields, "importFields", n.ImportFields, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "to", n.To, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "cC", n.Cc, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "bcc", n.Bcc, diags)
+	fields = helpers.AppendStringSetField(ctx, fields, "topics", n.Topics, diags)
+
+	return fields
+}
+
+// maskFields appends the masked value of the secrets set in the prior state but not in the plan, so that the API keeps them.
+func (n *Notification) maskFields(fields []lidarr.Field, prior *Notification) []lidarr.Field {
+	fields = helpers.AppendMaskedField(fields, "accessToken", n.AccessToken, prior.AccessToken)
+	fields = helpers.AppendMaskedField(fields, "accessTokenSecret", n.AccessTokenSecret, prior.AccessTokenSecret)
+	fields = helpers.AppendMaskedField(fields, "apiKey", n.APIKey, prior.APIKey)
+	fields = helpers.AppendMaskedField(fields, "aPIKey", n.APIKey, prior.APIKey)
+	fields = helpers.AppendMaskedField(fields, "appToken", n.AppToken, prior.AppToken)
+	fields = helpers.AppendMaskedField(fields, "authToken", n.AuthToken, prior.AuthToken)
+	fields = helpers.AppendMaskedField(fields, "configurationKey", n.ConfigurationKey, prior.ConfigurationKey)
+	fields = helpers.AppendMaskedField(fields, "authPassword", n.AuthPassword, prior.AuthPassword)
+	fields = helpers.AppendMaskedField(fields, "botToken", n.BotToken, prior.BotToken)
+	fields = helpers.AppendMaskedField(fields, "consumerKey", n.ConsumerKey, prior.ConsumerKey)
+	fields = helpers.AppendMaskedField(fields, "consumerSecret", n.ConsumerSecret, prior.ConsumerSecret)
+	fields = helpers.AppendMaskedField(fields, "key", n.Key, prior.Key)
+	fields = helpers.AppendMaskedField(fields, "password", n.Password, prior.Password)
+	fields = helpers.AppendMaskedField(fields, "senderNumber", n.SenderNumber, prior.SenderNumber)
+	fields = helpers.AppendMaskedField(fields, "userKey", n.UserKey, prior.UserKey)
+
+	return fields
+}
+
+// withNotificationFieldAttributes adds the field attributes to the Notification resource schema.
+func withNotificationFieldAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["always_update"] = schema.BoolAttribute{
+		MarkdownDescription: "Always update flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["clean_library"] = schema.BoolAttribute{
+		MarkdownDescription: "Clean library flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["direct_message"] = schema.BoolAttribute{
+		MarkdownDescription: "Direct message flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["notify"] = schema.BoolAttribute{
+		MarkdownDescription: "Notify flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["require_encryption"] = schema.BoolAttribute{
+		MarkdownDescription: "Require encryption flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["send_silently"] = schema.BoolAttribute{
+		MarkdownDescription: "Add silently flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["update_library"] = schema.BoolAttribute{
+		MarkdownDescription: "Update library flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["use_eu_endpoint"] = schema.BoolAttribute{
+		MarkdownDescription: "Use EU endpoint flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["use_ssl"] = schema.BoolAttribute{
+		MarkdownDescription: "Use SSL flag.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["port"] = schema.Int64Attribute{
+		MarkdownDescription: "Port.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["method"] = schema.Int64Attribute{
+		MarkdownDescription: "Method. `1` POST, `2` PUT.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.OneOf(1, 2),
+		},
+	}
+	attributes["priority"] = schema.Int64Attribute{
+		MarkdownDescription: "Priority.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.OneOf(-2, -1, 0, 1, 2, 3, 4, 5, 7),
+		},
+	}
+	attributes["notification_type"] = schema.Int64Attribute{
+		MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.OneOf(0, 1, 2, 3),
+		},
+	}
+	attributes["retry"] = schema.Int64Attribute{
+		MarkdownDescription: "Retry.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["expire"] = schema.Int64Attribute{
+		MarkdownDescription: "Expire.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["access_token"] = schema.StringAttribute{
+		MarkdownDescription: "Access token.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["access_token_secret"] = schema.StringAttribute{
+		MarkdownDescription: "Access token secret.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["api_key"] = schema.StringAttribute{
+		MarkdownDescription: "API key.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["app_token"] = schema.StringAttribute{
+		MarkdownDescription: "App token.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["arguments"] = schema.StringAttribute{
+		MarkdownDescription: "Arguments.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["author"] = schema.StringAttribute{
+		MarkdownDescription: "Author.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["auth_token"] = schema.StringAttribute{
+		MarkdownDescription: "Auth token.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["auth_user"] = schema.StringAttribute{
+		MarkdownDescription: "Auth user.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["server_url"] = schema.StringAttribute{
+		MarkdownDescription: "Server URL.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["stateless_urls"] = schema.StringAttribute{
+		MarkdownDescription: "Stateless URLs.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["configuration_key"] = schema.StringAttribute{
+		MarkdownDescription: "Configuration key.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["auth_username"] = schema.StringAttribute{
+		MarkdownDescription: "Username.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["auth_password"] = schema.StringAttribute{
+		MarkdownDescription: "Password.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["avatar"] = schema.StringAttribute{
+		MarkdownDescription: "Avatar.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["bot_token"] = schema.StringAttribute{
+		MarkdownDescription: "Bot token.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["channel"] = schema.StringAttribute{
+		MarkdownDescription: "Channel.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["chat_id"] = schema.StringAttribute{
+		MarkdownDescription: "Chat ID.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["consumer_key"] = schema.StringAttribute{
+		MarkdownDescription: "Consumer key.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["consumer_secret"] = schema.StringAttribute{
+		MarkdownDescription: "Consumer secret.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["device_names"] = schema.StringAttribute{
+		MarkdownDescription: "Device names.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["display_time"] = schema.Int64Attribute{
+		MarkdownDescription: "Display time.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["expires"] = schema.StringAttribute{
+		MarkdownDescription: "Expires.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["event"] = schema.StringAttribute{
+		MarkdownDescription: "Event.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["key"] = schema.StringAttribute{
+		MarkdownDescription: "Key.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["from"] = schema.StringAttribute{
+		MarkdownDescription: "From.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["host"] = schema.StringAttribute{
+		MarkdownDescription: "Host.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["icon"] = schema.StringAttribute{
+		MarkdownDescription: "Icon.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["mention"] = schema.StringAttribute{
+		MarkdownDescription: "Mention.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["password"] = schema.StringAttribute{
+		MarkdownDescription: "password.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["path"] = schema.StringAttribute{
+		MarkdownDescription: "Path.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["refresh_token"] = schema.StringAttribute{
+		MarkdownDescription: "Refresh token.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["sender_domain"] = schema.StringAttribute{
+		MarkdownDescription: "Sender domain.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["sender_id"] = schema.StringAttribute{
+		MarkdownDescription: "Sender ID.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["sender_number"] = schema.StringAttribute{
+		MarkdownDescription: "Sender Number.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["receiver_id"] = schema.StringAttribute{
+		MarkdownDescription: "Receiver ID.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["server"] = schema.StringAttribute{
+		MarkdownDescription: "server.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["sign_in"] = schema.StringAttribute{
+		MarkdownDescription: "Sign in.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["sound"] = schema.StringAttribute{
+		MarkdownDescription: "Sound.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["token"] = schema.StringAttribute{
+		MarkdownDescription: "Token.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["url"] = schema.StringAttribute{
+		MarkdownDescription: "URL.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["url_base"] = schema.StringAttribute{
+		MarkdownDescription: "URL base.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["click_url"] = schema.StringAttribute{
+		MarkdownDescription: "Click URL.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["user_key"] = schema.StringAttribute{
+		MarkdownDescription: "User key.",
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["username"] = schema.StringAttribute{
+		MarkdownDescription: "Username.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["web_hook_url"] = schema.StringAttribute{
+		MarkdownDescription: "Web hook url.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["channel_tags"] = schema.SetAttribute{
+		MarkdownDescription: "Channel tags.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["device_ids"] = schema.SetAttribute{
+		MarkdownDescription: "Device IDs.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["devices"] = schema.SetAttribute{
+		MarkdownDescription: "Devices.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["recipients"] = schema.SetAttribute{
+		MarkdownDescription: "Recipients.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["field_tags"] = schema.SetAttribute{
+		MarkdownDescription: "Tags and emojis.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["grab_fields"] = schema.SetAttribute{
+		MarkdownDescription: "Grab fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Group, `5` Size, `6` Links, `7` Release, `8` Poster, `9` Fanart.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["import_fields"] = schema.SetAttribute{
+		MarkdownDescription: "Import fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Codecs, `5` Group, `6` Size, `7` Languages, `8` Subtitles, `9` Links, `10` Release, `11` Poster, `12` Fanart.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["to"] = schema.SetAttribute{
+		MarkdownDescription: "To.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["cc"] = schema.SetAttribute{
+		MarkdownDescription: "Cc.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["bcc"] = schema.SetAttribute{
+		MarkdownDescription: "Bcc.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["topics"] = schema.SetAttribute{
+		MarkdownDescription: "Topics.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+
+	return attributes
+}
+
+// withNotificationFieldDataSourceAttributes adds the field attributes to the Notification data source schemas.
+func withNotificationFieldDataSourceAttributes(attributes map[string]dataSourceSchema.Attribute) map[string]dataSourceSchema.Attribute {
+	attributes["always_update"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Always update flag.",
+		Computed:            true,
+	}
+	attributes["clean_library"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Clean library flag.",
+		Computed:            true,
+	}
+	attributes["direct_message"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Direct message flag.",
+		Computed:            true,
+	}
+	attributes["notify"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Notify flag.",
+		Computed:            true,
+	}
+	attributes["require_encryption"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Require encryption flag.",
+		Computed:            true,
+	}
+	attributes["send_silently"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Add silently flag.",
+		Computed:            true,
+	}
+	attributes["update_library"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Update library flag.",
+		Computed:            true,
+	}
+	attributes["use_eu_endpoint"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Use EU endpoint flag.",
+		Computed:            true,
+	}
+	attributes["use_ssl"] = dataSourceSchema.BoolAttribute{
+		MarkdownDescription: "Use SSL flag.",
+		Computed:            true,
+	}
+	attributes["port"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Port.",
+		Computed:            true,
+	}
+	attributes["method"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Method. `1` POST, `2` PUT.",
+		Computed:            true,
+	}
+	attributes["priority"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Priority.",
+		Computed:            true,
+	}
+	attributes["notification_type"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
+		Computed:            true,
+	}
+	attributes["retry"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Retry.",
+		Computed:            true,
+	}
+	attributes["expire"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Expire.",
+		Computed:            true,
+	}
+	attributes["access_token"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Access token.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["access_token_secret"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Access token secret.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["api_key"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "API key.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["app_token"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "App token.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["arguments"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Arguments.",
+		Computed:            true,
+	}
+	attributes["author"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Author.",
+		Computed:            true,
+	}
+	attributes["auth_token"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Auth token.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["auth_user"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Auth user.",
+		Computed:            true,
+	}
+	attributes["server_url"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Server URL.",
+		Computed:            true,
+	}
+	attributes["stateless_urls"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Stateless URLs.",
+		Computed:            true,
+	}
+	attributes["configuration_key"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Configuration key.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["auth_username"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Username.",
+		Computed:            true,
+	}
+	attributes["auth_password"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Password.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["avatar"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Avatar.",
+		Computed:            true,
+	}
+	attributes["bot_token"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Bot token.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["channel"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Channel.",
+		Computed:            true,
+	}
+	attributes["chat_id"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Chat ID.",
+		Computed:            true,
+	}
+	attributes["consumer_key"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Consumer key.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["consumer_secret"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Consumer secret.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["device_names"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Device names.",
+		Computed:            true,
+	}
+	attributes["display_time"] = dataSourceSchema.Int64Attribute{
+		MarkdownDescription: "Display time.",
+		Computed:            true,
+	}
+	attributes["expires"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Expires.",
+		Computed:            true,
+	}
+	attributes["event"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Event.",
+		Computed:            true,
+	}
+	attributes["key"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Key.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["from"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "From.",
+		Computed:            true,
+	}
+	attributes["host"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Host.",
+		Computed:            true,
+	}
+	attributes["icon"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Icon.",
+		Computed:            true,
+	}
+	attributes["mention"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Mention.",
+		Computed:            true,
+	}
+	attributes["password"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "password.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["path"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Path.",
+		Computed:            true,
+	}
+	attributes["refresh_token"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Refresh token.",
+		Computed:            true,
+	}
+	attributes["sender_domain"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Sender domain.",
+		Computed:            true,
+	}
+	attributes["sender_id"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Sender ID.",
+		Computed:            true,
+	}
+	attributes["sender_number"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Sender Number.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["receiver_id"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Receiver ID.",
+		Computed:            true,
+	}
+	attributes["server"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "server.",
+		Computed:            true,
+	}
+	attributes["sign_in"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Sign in.",
+		Computed:            true,
+	}
+	attributes["sound"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Sound.",
+		Computed:            true,
+	}
+	attributes["token"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Token.",
+		Computed:            true,
+	}
+	attributes["url"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "URL.",
+		Computed:            true,
+	}
+	attributes["url_base"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "URL base.",
+		Computed:            true,
+	}
+	attributes["click_url"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Click URL.",
+		Computed:            true,
+	}
+	attributes["user_key"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "User key.",
+		Computed:            true,
+		Sensitive:           true,
+	}
+	attributes["username"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Username.",
+		Computed:            true,
+	}
+	attributes["web_hook_url"] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Web hook url.",
+		Computed:            true,
+	}
+	attributes["channel_tags"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Channel tags.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["device_ids"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Device IDs.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["devices"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Devices.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["recipients"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Recipients.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["field_tags"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Tags and emojis.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["grab_fields"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Grab fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Group, `5` Size, `6` Links, `7` Release, `8` Poster, `9` Fanart.",
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["import_fields"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Import fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Codecs, `5` Group, `6` Size, `7` Languages, `8` Subtitles, `9` Links, `10` Release, `11` Poster, `12` Fanart.",
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["to"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "To.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["cc"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Cc.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["bcc"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Bcc.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+	attributes["topics"] = dataSourceSchema.SetAttribute{
+		MarkdownDescription: "Topics.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+
+	return attributes
+}
+
+// notificationFieldNames maps the API field names to the Notification attributes managing them.
+var notificationFieldNames = map[string]string{
+	"alwaysUpdate":      "always_update",
+	"cleanLibrary":      "clean_library",
+	"directMessage":     "direct_message",
+	"notify":            "notify",
+	"requireEncryption": "require_encryption",
+	"sendSilently":      "send_silently",
+	"updateLibrary":     "update_library",
+	"useEuEndpoint":     "use_eu_endpoint",
+	"useSsl":            "use_ssl",
+	"port":              "port",
+	"method":            "method",
+	"priority":          "priority",
+	"notificationType":  "notification_type",
+	"retry":             "retry",
+	"expire":            "expire",
+	"accessToken":       "access_token",
+	"accessTokenSecret": "access_token_secret",
+	"apiKey":            "api_key",
+	"aPIKey":            "api_key",
+	"appToken":          "app_token",
+	"arguments":         "arguments",
+	"author":            "author",
+	"authToken":         "auth_token",
+	"authUser":          "auth_user",
+	"serverUrl":         "server_url",
+	"statelessUrls":     "stateless_urls",
+	"configurationKey":  "configuration_key",
+	"authUsername":      "auth_username",
+	"authPassword":      "auth_password",
+	"avatar":            "avatar",
+	"botToken":          "bot_token",
+	"channel":           "channel",
+	"chatId":            "chat_id",
+	"consumerKey":       "consumer_key",
+	"consumerSecret":    "consumer_secret",
+	"deviceNames":       "device_names",
+	"displayTime":       "display_time",
+	"expires":           "expires",
+	"event":             "event",
+	"key":               "key",
+	"from":              "from",
+	"host":              "host",
+	"icon":              "icon",
+	"mention":           "mention",
+	"password":          "password",
+	"path":              "path",
+	"refreshToken":      "refresh_token",
+	"senderDomain":      "sender_domain",
+	"senderId":          "sender_id",
+	"senderNumber":      "sender_number",
+	"receiverId":        "receiver_id",
+	"server":            "server",
+	"signIn":            "sign_in",
+	"sound":             "sound",
+	"token":             "token",
+	"url":               "url",
+	"urlBase":           "url_base",
+	"clickUrl":          "click_url",
+	"userKey":           "user_key",
+	"username":          "username",
+	"userName":          "username",
+	"webHookUrl":        "web_hook_url",
+	"channelTags":       "channel_tags",
+	"deviceIds":         "device_ids",
+	"devices":           "devices",
+	"recipients":        "recipients",
+	"tags":              "field_tags",
+	"grabFields":        "grab_fields",
+	"importFields":      "import_fields",
+	"to":                "to",
+	"cC":                "cc",
+	"bcc":               "bcc",
+	"topics":            "topics",
+}
@@ -34,8 +34,9 @@ func NewNotificationPlexResource() resource.Resource {
 
 // NotificationPlexResource defines the notification implementation.
 type NotificationPlexResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // NotificationPlex describes the notification data model.
@@ -54,6 +55,7 @@ type NotificationPlex struct {
 	OnTrackRetag    types.Bool   `tfsdk:"on_track_retag"`
 	OnRename        types.Bool   `tfsdk:"on_rename"`
 	OnUpgrade       types.Bool   `tfsdk:"on_upgrade"`
+	TestOnApply     types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationPlex) toNotification() *Notification {
@@ -140,6 +142,7 @@ func (r *NotificationPlexResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -180,6 +183,7 @@ func (r *NotificationPlexResource) Configure(ctx context.Context, req resource.C
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
 	}
 }
 
@@ -196,9 +200,17 @@ func (r *NotificationPlexResource) Create(ctx context.Context, req resource.Crea
 	// Create new NotificationPlex
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationPlexResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, notificationPlexResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPlexResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, notificationPlexResourceName, err, fieldValidationAttribute(notificationPlexResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -220,8 +232,15 @@ func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationPlex current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(notificationPlexResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPlexResourceName, err))
 
 		return
@@ -234,10 +253,11 @@ func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *NotificationPlexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var notification *NotificationPlex
+	// Get plan and state values
+	var notification, prior *NotificationPlex
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -245,10 +265,19 @@ func (r *NotificationPlexResource) Update(ctx context.Context, req resource.Upda
 
 	// Update NotificationPlex
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Fields = notification.toNotification().maskFields(request.Fields, prior.toNotification())
+
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationPlexResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, err := updateNotification(r.auth, r.client, request, notificationPlexResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPlexResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, notificationPlexResourceName, err, fieldValidationAttribute(notificationPlexResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -281,7 +310,9 @@ func (r *NotificationPlexResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationPlexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return notificationIDByName(r.auth, r.client, name, notificationPlexImplementation)
+	})
 	tflog.Trace(ctx, "imported "+notificationPlexResourceName+": "+req.ID)
 }
 
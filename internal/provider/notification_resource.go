@@ -2,11 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
-	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,16 +24,10 @@ const notificationResourceName = "notification"
 var (
 	_ resource.Resource                = &NotificationResource{}
 	_ resource.ResourceWithImportState = &NotificationResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationResource{}
 )
 
-var notificationFields = helpers.Fields{
-	Bools:                  []string{"alwaysUpdate", "cleanLibrary", "directMessage", "notify", "requireEncryption", "sendSilently", "updateLibrary", "useEuEndpoint", "useSsl"},
-	Strings:                []string{"accessToken", "accessTokenSecret", "apiKey", "aPIKey", "appToken", "arguments", "author", "authToken", "authUser", "avatar", "botToken", "channel", "chatId", "consumerKey", "consumerSecret", "deviceNames", "expires", "from", "host", "icon", "mention", "password", "path", "refreshToken", "senderDomain", "senderId", "server", "signIn", "sound", "token", "urlBase", "url", "userKey", "username", "userName", "webHookUrl", "authUsername", "authPassword", "statelessUrls", "configurationKey", "serverUrl", "clickUrl", "event", "key", "senderNumber", "receiverId"},
-	Ints:                   []string{"method", "port", "priority", "displayTime", "retry", "expire", "notificationType"},
-	StringSlices:           []string{"channelTags", "deviceIds", "devices", "recipients", "to", "cC", "bcc", "fieldTags", "topics"},
-	StringSlicesExceptions: []string{"tags"},
-	IntSlices:              []string{"grabFields", "importFields"},
-}
+//go:generate go run ../../tools/fieldgen -spec fieldspecs/notification.json -out notification_fields_gen.go
 
 func NewNotificationResource() resource.Resource {
 	return &NotificationResource{}
@@ -42,8 +35,10 @@ func NewNotificationResource() resource.Resource {
 
 // NotificationResource defines the notification implementation.
 type NotificationResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
+	data        *LidarrData
 }
 
 // Notification describes the notification data model.
@@ -139,6 +134,14 @@ type Notification struct {
 	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
 }
 
+// notificationResourceModel describes the generic notification resource data model.
+type notificationResourceModel struct {
+	Fields          types.Map  `tfsdk:"fields"`
+	SensitiveFields types.Map  `tfsdk:"sensitive_fields"`
+	TestOnApply     types.Bool `tfsdk:"test_on_apply"`
+	Notification
+}
+
 func (n Notification) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -241,7 +244,7 @@ func (r *NotificationResource) Metadata(_ context.Context, req resource.Metadata
 func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nGeneric Notification resource. When possible use a specific resource instead.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect).",
-		Attributes: map[string]schema.Attribute{
+		Attributes: withNotificationFieldAttributes(map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -325,6 +328,9 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"fields":           fieldsAttribute(),
+			"sensitive_fields": sensitiveFieldsAttribute(),
+			"test_on_apply":    testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -332,391 +338,7 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			// Field values
-			"always_update": schema.BoolAttribute{
-				MarkdownDescription: "Always update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"clean_library": schema.BoolAttribute{
-				MarkdownDescription: "Clean library flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"direct_message": schema.BoolAttribute{
-				MarkdownDescription: "Direct message flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"notify": schema.BoolAttribute{
-				MarkdownDescription: "Notify flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"require_encryption": schema.BoolAttribute{
-				MarkdownDescription: "Require encryption flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"send_silently": schema.BoolAttribute{
-				MarkdownDescription: "Add silently flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"update_library": schema.BoolAttribute{
-				MarkdownDescription: "Update library flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"use_eu_endpoint": schema.BoolAttribute{
-				MarkdownDescription: "Use EU endpoint flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"use_ssl": schema.BoolAttribute{
-				MarkdownDescription: "Use SSL flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"port": schema.Int64Attribute{
-				MarkdownDescription: "Port.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"method": schema.Int64Attribute{
-				MarkdownDescription: "Method. `1` POST, `2` PUT.",
-				Optional:            true,
-				Computed:            true,
-				Validators: []validator.Int64{
-					int64validator.OneOf(1, 2),
-				},
-			},
-			"priority": schema.Int64Attribute{
-				MarkdownDescription: "Priority.", // TODO: add values in description
-				Optional:            true,
-				Computed:            true,
-				Validators: []validator.Int64{
-					int64validator.OneOf(-2, -1, 0, 1, 2, 3, 4, 5, 7),
-				},
-			},
-			"notification_type": schema.Int64Attribute{
-				MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
-				Optional:            true,
-				Computed:            true,
-				Validators: []validator.Int64{
-					int64validator.OneOf(0, 1, 2, 3),
-				},
-			},
-			"retry": schema.Int64Attribute{
-				MarkdownDescription: "Retry.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"expire": schema.Int64Attribute{
-				MarkdownDescription: "Expire.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"access_token": schema.StringAttribute{
-				MarkdownDescription: "Access token.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"access_token_secret": schema.StringAttribute{
-				MarkdownDescription: "Access token secret.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"app_token": schema.StringAttribute{
-				MarkdownDescription: "App token.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"arguments": schema.StringAttribute{
-				MarkdownDescription: "Arguments.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"author": schema.StringAttribute{
-				MarkdownDescription: "Author.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"auth_token": schema.StringAttribute{
-				MarkdownDescription: "Auth token.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"auth_user": schema.StringAttribute{
-				MarkdownDescription: "Auth user.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"server_url": schema.StringAttribute{
-				MarkdownDescription: "Server URL.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"stateless_urls": schema.StringAttribute{
-				MarkdownDescription: "Stateless URLs.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"configuration_key": schema.StringAttribute{
-				MarkdownDescription: "Configuration key.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"auth_username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"auth_password": schema.StringAttribute{
-				MarkdownDescription: "Password.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"avatar": schema.StringAttribute{
-				MarkdownDescription: "Avatar.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"bot_token": schema.StringAttribute{
-				MarkdownDescription: "Bot token.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"channel": schema.StringAttribute{
-				MarkdownDescription: "Channel.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"chat_id": schema.StringAttribute{
-				MarkdownDescription: "Chat ID.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"consumer_key": schema.StringAttribute{
-				MarkdownDescription: "Consumer key.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"consumer_secret": schema.StringAttribute{
-				MarkdownDescription: "Consumer secret.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"device_names": schema.StringAttribute{
-				MarkdownDescription: "Device names.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"display_time": schema.Int64Attribute{
-				MarkdownDescription: "Display time.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"expires": schema.StringAttribute{
-				MarkdownDescription: "Expires.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"event": schema.StringAttribute{
-				MarkdownDescription: "Event.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"key": schema.StringAttribute{
-				MarkdownDescription: "Key.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"from": schema.StringAttribute{
-				MarkdownDescription: "From.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"host": schema.StringAttribute{
-				MarkdownDescription: "Host.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"icon": schema.StringAttribute{
-				MarkdownDescription: "Icon.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"mention": schema.StringAttribute{
-				MarkdownDescription: "Mention.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"password": schema.StringAttribute{
-				MarkdownDescription: "password.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"path": schema.StringAttribute{
-				MarkdownDescription: "Path.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"refresh_token": schema.StringAttribute{
-				MarkdownDescription: "Refresh token.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"sender_domain": schema.StringAttribute{
-				MarkdownDescription: "Sender domain.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"sender_id": schema.StringAttribute{
-				MarkdownDescription: "Sender ID.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"sender_number": schema.StringAttribute{
-				MarkdownDescription: "Sender Number.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"receiver_id": schema.StringAttribute{
-				MarkdownDescription: "Receiver ID.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"server": schema.StringAttribute{
-				MarkdownDescription: "server.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"sign_in": schema.StringAttribute{
-				MarkdownDescription: "Sign in.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"sound": schema.StringAttribute{
-				MarkdownDescription: "Sound.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"token": schema.StringAttribute{
-				MarkdownDescription: "Token.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"url": schema.StringAttribute{
-				MarkdownDescription: "URL.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"url_base": schema.StringAttribute{
-				MarkdownDescription: "URL base.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"click_url": schema.StringAttribute{
-				MarkdownDescription: "Click URL.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"user_key": schema.StringAttribute{
-				MarkdownDescription: "User key.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"web_hook_url": schema.StringAttribute{
-				MarkdownDescription: "Web hook url.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"channel_tags": schema.SetAttribute{
-				MarkdownDescription: "Channel tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"device_ids": schema.SetAttribute{
-				MarkdownDescription: "Device IDs.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"devices": schema.SetAttribute{
-				MarkdownDescription: "Devices.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"recipients": schema.SetAttribute{
-				MarkdownDescription: "Recipients.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"field_tags": schema.SetAttribute{
-				MarkdownDescription: "Tags and emojis.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"grab_fields": schema.SetAttribute{
-				MarkdownDescription: "Grab fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Group, `5` Size, `6` Links, `7` Release, `8` Poster, `9` Fanart.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"import_fields": schema.SetAttribute{
-				MarkdownDescription: "Import fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Codecs, `5` Group, `6` Size, `7` Languages, `8` Subtitles, `9` Links, `10` Release, `11` Poster, `12` Fanart.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"to": schema.SetAttribute{
-				MarkdownDescription: "To.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"cc": schema.SetAttribute{
-				MarkdownDescription: "Cc.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"bcc": schema.SetAttribute{
-				MarkdownDescription: "Bcc.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"topics": schema.SetAttribute{
-				MarkdownDescription: "Topics.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
+		}),
 	}
 }
 
@@ -724,12 +346,33 @@ func (r *NotificationResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
+		r.data = resourceLidarrData(req)
 	}
 }
 
+func (r *NotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or if provider is not configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	checkVersionRequirements(ctx, req.Config, r.data, notificationVersionRequirements, &resp.Diagnostics)
+
+	var notification *notificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
+
+	if resp.Diagnostics.HasError() || notification.Implementation.IsUnknown() {
+		return
+	}
+
+	r.freeFields(ctx, notification, &resp.Diagnostics)
+}
+
 func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var notification *Notification
+	var notification *notificationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -739,10 +382,19 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Create new Notification
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, notification, &resp.Diagnostics))
+
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, err := createNotification(r.auth, r.client, request, notificationResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, notificationResourceName, err, fieldValidationAttribute(notificationResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -750,16 +402,19 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state notificationResourceModel
 
-	state.writeSensitive(notification)
+	state.writeSensitive(&notification.Notification)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = notification.TestOnApply
+	state.Fields = writeFreeFields(ctx, notification.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, notification.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var notification *Notification
+	var notification *notificationResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &notification)...)
 
@@ -768,8 +423,15 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get Notification current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(notificationResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationResourceName, err))
 
 		return
@@ -778,18 +440,22 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state notificationResourceModel
 
-	state.writeSensitive(notification)
+	state.writeSensitive(&notification.Notification)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = notification.TestOnApply
+	state.Fields = writeFreeFields(ctx, notification.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, notification.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var notification *Notification
+	// Get plan and state values
+	var notification, prior *notificationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -797,10 +463,20 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Update Notification
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Fields = notification.maskFields(request.Fields, &prior.Notification)
+	request.Fields = mergeFields(request.Fields, r.freeFields(ctx, notification, &resp.Diagnostics))
+
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, err := updateNotification(r.auth, r.client, request, notificationResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, notificationResourceName, err, fieldValidationAttribute(notificationResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -808,10 +484,13 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state notificationResourceModel
 
-	state.writeSensitive(notification)
+	state.writeSensitive(&notification.Notification)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TestOnApply = notification.TestOnApply
+	state.Fields = writeFreeFields(ctx, notification.Fields, response.GetFields(), &resp.Diagnostics)
+	state.SensitiveFields = writeFreeFields(ctx, notification.SensitiveFields, response.GetFields(), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -837,10 +516,62 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *NotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return notificationIDByName(r.auth, r.client, name, "")
+	})
 	tflog.Trace(ctx, "imported "+notificationResourceName+": "+req.ID)
 }
 
+// testNotification tests the notification connection, reporting the validation failures on the related attributes.
+func testNotification(auth context.Context, client *lidarr.APIClient, notification *lidarr.NotificationResource, name string, diags *diag.Diagnostics) {
+	if _, err := client.NotificationAPI.TestNotification(auth).NotificationResource(*notification).Execute(); err != nil {
+		helpers.AddValidationDiagnostics(diags, helpers.TestConnection, name, err, fieldValidationAttribute(name, notificationFieldNames, notification.GetFields()))
+	}
+}
+
+// createNotification creates the notification. When Lidarr only reports validation warnings,
+// they are reported as such and the notification is saved anyway.
+func createNotification(auth context.Context, client *lidarr.APIClient, notification *lidarr.NotificationResource, name string, diags *diag.Diagnostics) (*lidarr.NotificationResource, error) {
+	response, _, err := client.NotificationAPI.CreateNotification(auth).NotificationResource(*notification).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Create, name, err, fieldValidationAttribute(name, notificationFieldNames, notification.GetFields()))
+		response, _, err = client.NotificationAPI.CreateNotification(auth).NotificationResource(*notification).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// updateNotification updates the notification. When Lidarr only reports validation warnings,
+// they are reported as such and the notification is saved anyway.
+func updateNotification(auth context.Context, client *lidarr.APIClient, notification *lidarr.NotificationResource, name string, diags *diag.Diagnostics) (*lidarr.NotificationResource, error) {
+	response, _, err := client.NotificationAPI.UpdateNotification(auth, notification.GetId()).NotificationResource(*notification).Execute()
+	if helpers.OnlyValidationWarnings(err) {
+		helpers.AddValidationDiagnostics(diags, helpers.Update, name, err, fieldValidationAttribute(name, notificationFieldNames, notification.GetFields()))
+		response, _, err = client.NotificationAPI.UpdateNotification(auth, notification.GetId()).NotificationResource(*notification).ForceSave(true).Execute()
+	}
+
+	return response, err
+}
+
+// freeFields validates the free-form fields against the implementation schema and returns their API representation.
+func (r *NotificationResource) freeFields(ctx context.Context, notification *notificationResourceModel, diags *diag.Diagnostics) []lidarr.Field {
+	if !hasFreeFields(notification.Fields, notification.SensitiveFields) {
+		return nil
+	}
+
+	schemas, _, err := r.client.NotificationAPI.ListNotificationSchema(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, notificationResourceName+" schema", err))
+
+		return nil
+	}
+
+	fields, found := schemaFields(schemas, notification.Implementation.ValueString())
+	validateImplementation(found, notification.Implementation.ValueString(), diags)
+
+	return readFreeFields(ctx, notification.Fields, notification.SensitiveFields, fields, notificationFieldNames, diags)
+}
+
 func (n *Notification) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -875,7 +606,7 @@ func (n *Notification) write(ctx context.Context, notification *lidarr.Notificat
 	n.Bcc = types.SetValueMust(types.StringType, nil)
 	n.FieldTags = types.SetValueMust(types.StringType, nil)
 	n.Topics = types.SetValueMust(types.StringType, nil)
-	helpers.WriteFields(ctx, n, notification.GetFields(), notificationFields)
+	n.writeFields(notification.GetFields(), diags)
 }
 
 func (n *Notification) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.NotificationResource {
@@ -898,7 +629,7 @@ func (n *Notification) read(ctx context.Context, diags *diag.Diagnostics) *lidar
 	notification.SetImplementation(n.Implementation.ValueString())
 	notification.SetConfigContract(n.ConfigContract.ValueString())
 	diags.Append(n.Tags.ElementsAs(ctx, &notification.Tags, true)...)
-	notification.SetFields(helpers.ReadFields(ctx, n, notificationFields))
+	notification.SetFields(n.readFields(ctx, diags))
 
 	return notification
 }
@@ -953,3 +684,13 @@ func (n *Notification) writeSensitive(notification *Notification) {
 		n.SenderNumber = notification.SenderNumber
 	}
 }
+
+// notificationIDByName returns the ID of the notification with the given name, of the given implementation unless empty.
+func notificationIDByName(auth context.Context, client *lidarr.APIClient, name, implementation string) (int32, error) {
+	response, _, err := client.NotificationAPI.ListNotification(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, notificationResourceName, err))
+	}
+
+	return helpers.FindImplementationIDByName(notificationResourceName, name, implementation, response, (*lidarr.NotificationResource).GetName, (*lidarr.NotificationResource).GetImplementation, (*lidarr.NotificationResource).GetId)
+}
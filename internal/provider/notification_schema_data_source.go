@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const notificationSchemaDataSourceName = "notification_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NotificationSchemaDataSource{}
+
+func NewNotificationSchemaDataSource() datasource.DataSource {
+	return &NotificationSchemaDataSource{}
+}
+
+// NotificationSchemaDataSource defines the notification schema implementation.
+type NotificationSchemaDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+func (d *NotificationSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + notificationSchemaDataSourceName
+}
+
+func (d *NotificationSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Notifications -->\nList the fields of each Notification implementation, as exposed by the Lidarr schema endpoint.",
+		Attributes:          implementationSchemasAttributes(),
+	}
+}
+
+func (d *NotificationSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *NotificationSchemaDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get notification schema current value
+	response, _, err := d.client.NotificationAPI.ListNotificationSchema(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, notificationSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+notificationSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	schemas := writeImplementationSchemas(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, schemas)...)
+}
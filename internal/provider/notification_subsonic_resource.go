@@ -26,6 +26,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationSubsonicResource{}
 	_ resource.ResourceWithImportState = &NotificationSubsonicResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSubsonicResource{}
 )
 
 func NewNotificationSubsonicResource() resource.Resource {
@@ -34,8 +35,10 @@ func NewNotificationSubsonicResource() resource.Resource {
 
 // NotificationSubsonicResource defines the notification implementation.
 type NotificationSubsonicResource struct {
-	client *lidarr.APIClient
-	auth   context.Context
+	client      *lidarr.APIClient
+	auth        context.Context
+	testOnApply bool
+	data        *LidarrData
 }
 
 // NotificationSubsonic describes the notification data model.
@@ -60,6 +63,7 @@ type NotificationSubsonic struct {
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationSubsonic) toNotification() *Notification {
@@ -175,6 +179,7 @@ func (r *NotificationSubsonicResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -230,9 +235,15 @@ func (r *NotificationSubsonicResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.testOnApply = resourceTestOnApply(req)
+		r.data = resourceLidarrData(req)
 	}
 }
 
+func (r *NotificationSubsonicResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkVersionRequirements(ctx, req.Config, r.data, notificationVersionRequirements, &resp.Diagnostics)
+}
+
 func (r *NotificationSubsonicResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationSubsonic
@@ -246,9 +257,17 @@ func (r *NotificationSubsonicResource) Create(ctx context.Context, req resource.
 	// Create new NotificationSubsonic
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationSubsonicResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, notificationSubsonicResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSubsonicResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, notificationSubsonicResourceName, err, fieldValidationAttribute(notificationSubsonicResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -270,8 +289,15 @@ func (r *NotificationSubsonicResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationSubsonic current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(notificationSubsonicResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSubsonicResourceName, err))
 
 		return
@@ -284,10 +310,11 @@ func (r *NotificationSubsonicResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *NotificationSubsonicResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var notification *NotificationSubsonic
+	// Get plan and state values
+	var notification, prior *NotificationSubsonic
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -295,10 +322,19 @@ func (r *NotificationSubsonicResource) Update(ctx context.Context, req resource.
 
 	// Update NotificationSubsonic
 	request := notification.read(ctx, &resp.Diagnostics)
+	request.Fields = notification.toNotification().maskFields(request.Fields, prior.toNotification())
+
+	if isTestOnApply(notification.TestOnApply, r.testOnApply) {
+		testNotification(r.auth, r.client, request, notificationSubsonicResourceName, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	response, err := updateNotification(r.auth, r.client, request, notificationSubsonicResourceName, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationSubsonicResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, notificationSubsonicResourceName, err, fieldValidationAttribute(notificationSubsonicResourceName, notificationFieldNames, request.GetFields()))
 
 		return
 	}
@@ -331,7 +367,9 @@ func (r *NotificationSubsonicResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationSubsonicResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return notificationIDByName(r.auth, r.client, name, notificationSubsonicImplementation)
+	})
 	tflog.Trace(ctx, "imported "+notificationSubsonicResourceName+": "+req.ID)
 }
 
@@ -3,24 +3,45 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	defaultMaxRetries    = 3
+	defaultRetryWaitMin  = 1
+	defaultRetryWaitMax  = 30
+	defaultReadyTimeout  = 300
+	defaultReadyInterval = 5
 )
 
 // needed for tf debug mode
 // var stderr = os.Stderr
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ provider.Provider = &LidarrProvider{}
+var (
+	_ provider.Provider            = &LidarrProvider{}
+	_ provider.ProviderWithActions = &LidarrProvider{}
+)
 
 // ScaffoldingProvider defines the provider implementation.
 type LidarrProvider struct {
@@ -32,9 +53,19 @@ type LidarrProvider struct {
 
 // Lidarr describes the provider data model.
 type Lidarr struct {
-	ExtraHeaders types.Set    `tfsdk:"extra_headers"`
-	APIKey       types.String `tfsdk:"api_key"`
-	URL          types.String `tfsdk:"url"`
+	ExtraHeaders       types.Set    `tfsdk:"extra_headers"`
+	APIKey             types.String `tfsdk:"api_key"`
+	URL                types.String `tfsdk:"url"`
+	CACertificate      types.String `tfsdk:"ca_certificate"`
+	ClientCertificate  types.String `tfsdk:"client_certificate"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin       types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax       types.Int64  `tfsdk:"retry_wait_max"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
+	WaitForReady       types.Object `tfsdk:"wait_for_ready"`
 }
 
 // ExtraHeader is part of Lidarr.
@@ -43,10 +74,20 @@ type ExtraHeader struct {
 	Value types.String `tfsdk:"value"`
 }
 
+// WaitForReady is part of Lidarr.
+type WaitForReady struct {
+	Timeout  types.Int64 `tfsdk:"timeout"`
+	Interval types.Int64 `tfsdk:"interval"`
+}
+
 // LidarrData defines auth and client to be used when connecting to Lidarr.
 type LidarrData struct {
 	Auth   context.Context
 	Client *lidarr.APIClient
+	// TestOnApply is the default of the test_on_apply resource attribute.
+	TestOnApply bool
+	version     *version.Version
+	versionOnce sync.Once
 }
 
 func (p *LidarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -83,6 +124,77 @@ func (p *LidarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					},
 				},
 			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds of each request attempt. Defaults to no timeout. Can be specified via the `LIDARR_TIMEOUT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate, or path to it, to be trusted in addition to the system ones. Can be specified via the `LIDARR_CA_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, or path to it, for mutual TLS authentication. Requires `client_key`. Can be specified via the `LIDARR_CLIENT_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key, or path to it, for mutual TLS authentication. Requires `client_certificate`. Can be specified via the `LIDARR_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip TLS certificate verification. **NOT** recommended outside of testing. Can be specified via the `LIDARR_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Test the connection of indexers, download clients, notifications and import lists before saving them, unless overridden by their `test_on_apply` attribute. Defaults to `false`. Can be specified via the `LIDARR_TEST_ON_APPLY` environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for transient errors (connection errors, `429` and `5xx` responses). Only idempotent requests are retried, unless the connection was refused. Defaults to `3`, set `0` to disable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: "Minimum time in seconds to wait before retrying, doubled at each attempt. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait before retrying. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AtLeastSumOf(path.MatchRoot("retry_wait_min")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"wait_for_ready": schema.SingleNestedBlock{
+				MarkdownDescription: "Wait for Lidarr to answer before the first API call, e.g. while it starts and migrates its database. Plans not calling the API, such as when Lidarr is created in the same apply, do not wait.",
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.Int64Attribute{
+						MarkdownDescription: "Maximum time in seconds to wait. Defaults to `300`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"interval": schema.Int64Attribute{
+						MarkdownDescription: "Time in seconds between two attempts, each one a single request not subject to `max_retries`. Defaults to `5`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -147,29 +259,99 @@ func (p *LidarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
-	// Set context for API calls
-	auth := context.WithValue(
-		context.Background(),
-		lidarr.ContextAPIKeys,
-		map[string]lidarr.APIKey{
-			"X-Api-Key": {Key: key},
-		},
+	// Init HTTP client
+	options, err := httpOptions(data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse HTTP options",
+			err.Error(),
+		)
+
+		return
+	}
+
+	client, err := helpers.NewHTTPClient(options)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to configure HTTP client",
+			err.Error(),
+		)
+
+		return
+	}
+
+	// Retry transient errors
+	config.HTTPClient = helpers.NewRetryableHTTPClient(
+		client,
+		int(int64ValueOrDefault(data.MaxRetries, defaultMaxRetries)),
+		time.Duration(int64ValueOrDefault(data.RetryWaitMin, defaultRetryWaitMin))*time.Second,
+		time.Duration(int64ValueOrDefault(data.RetryWaitMax, defaultRetryWaitMax))*time.Second,
 	)
-	auth = context.WithValue(auth, lidarr.ContextServerVariables, map[string]string{
-		"protocol": parsedAPIURL.Scheme,
-		"hostpath": parsedAPIURL.Host,
-	})
+
+	testOnApply, err := boolValueOrEnv(data.TestOnApply, "LIDARR_TEST_ON_APPLY")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse test on apply option",
+			err.Error(),
+		)
+
+		return
+	}
+
+	// Set context for API calls, keeping the provider logger but not the request cancellation
+	auth := helpers.NewAuthContext(context.WithoutCancel(ctx), parsedAPIURL, key)
+
+	// Wait for Lidarr to be ready before the first API call, not to block plans
+	if !data.WaitForReady.IsNull() {
+		var wait WaitForReady
+
+		resp.Diagnostics.Append(data.WaitForReady.As(ctx, &wait, basetypes.ObjectAsOptions{})...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Poll without retries, so that each attempt is a single request
+		readyConfig := *config
+		readyConfig.HTTPClient = client
+		readyClient := lidarr.NewAPIClient(&readyConfig)
+
+		gatedClient := *config.HTTPClient
+		gatedClient.Transport = &readyRoundTripper{
+			next: config.HTTPClient.Transport,
+			wait: func() error {
+				return waitForReady(
+					auth,
+					time.Duration(int64ValueOrDefault(wait.Timeout, defaultReadyTimeout))*time.Second,
+					time.Duration(int64ValueOrDefault(wait.Interval, defaultReadyInterval))*time.Second,
+					func(ctx context.Context) (*http.Response, error) {
+						_, httpResp, err := readyClient.SystemAPI.GetSystemStatus(ctx).Execute()
+
+						return httpResp, err
+					},
+				)
+			},
+		}
+		config.HTTPClient = &gatedClient
+	}
 
 	lidarrData := LidarrData{
-		Auth:   auth,
-		Client: lidarr.NewAPIClient(config),
+		Auth:        auth,
+		Client:      lidarr.NewAPIClient(config),
+		TestOnApply: testOnApply,
 	}
+
 	resp.DataSourceData = &lidarrData
 	resp.ResourceData = &lidarrData
+	resp.ActionData = &lidarrData
 }
 
 func (p *LidarrProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		// Albums
+		NewAlbumResource,
+		NewArtistAlbumMonitoringResource,
+
 		// Artists
 		NewArtistResource,
 
@@ -270,6 +452,7 @@ func (p *LidarrProvider) Resources(_ context.Context) []func() resource.Resource
 		NewCustomFormatResource,
 
 		// System
+		NewCommandResource,
 		NewHostResource,
 
 		// Tags
@@ -277,16 +460,38 @@ func (p *LidarrProvider) Resources(_ context.Context) []func() resource.Resource
 	}
 }
 
+func (p *LidarrProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		// Artists
+		NewRefreshArtistAction,
+
+		// Media Management
+		NewRescanRootFolderAction,
+
+		// System
+		NewBackupAction,
+		NewRssSyncAction,
+		NewSearchMissingAction,
+	}
+}
+
 func (p *LidarrProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		// Albums
+		NewAlbumDataSource,
+		NewAlbumLookupDataSource,
+		NewAlbumsDataSource,
+
 		// Artists
 		NewArtistDataSource,
+		NewArtistLookupDataSource,
 		NewArtistsDataSource,
 
 		// Download Clients
 		NewDownloadClientConfigDataSource,
 		NewDownloadClientDataSource,
 		NewDownloadClientsDataSource,
+		NewDownloadClientSchemaDataSource,
 		NewRemotePathMappingDataSource,
 		NewRemotePathMappingsDataSource,
 
@@ -294,10 +499,12 @@ func (p *LidarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewIndexerConfigDataSource,
 		NewIndexerDataSource,
 		NewIndexersDataSource,
+		NewIndexerSchemaDataSource,
 
 		// Import Lists
 		NewImportListDataSource,
 		NewImportListsDataSource,
+		NewImportListSchemaDataSource,
 		NewImportListExclusionDataSource,
 		NewImportListExclusionsDataSource,
 
@@ -315,6 +522,7 @@ func (p *LidarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		// Notifications
 		NewNotificationDataSource,
 		NewNotificationsDataSource,
+		NewNotificationSchemaDataSource,
 
 		// Profiles
 		NewCustomFormatDataSource,
@@ -398,3 +606,86 @@ func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, res
 
 	return providerData.Auth, providerData.Client
 }
+
+func actionConfigure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) (context.Context, *lidarr.APIClient) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return nil, nil
+	}
+
+	providerData, ok := req.ProviderData.(*LidarrData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			helpers.UnexpectedActionConfigureType,
+			fmt.Sprintf("Expected *LidarrData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return nil, nil
+	}
+
+	return providerData.Auth, providerData.Client
+}
+
+func int64ValueOrDefault(value types.Int64, defaultValue int64) int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultValue
+	}
+
+	return value.ValueInt64()
+}
+
+// httpOptions extracts the HTTP client options, falling back to environment variables.
+func httpOptions(data Lidarr) (helpers.HTTPOptions, error) {
+	options := helpers.HTTPOptions{
+		CACertificate:      stringValueOrEnv(data.CACertificate, "LIDARR_CA_CERTIFICATE"),
+		ClientCertificate:  stringValueOrEnv(data.ClientCertificate, "LIDARR_CLIENT_CERTIFICATE"),
+		ClientKey:          stringValueOrEnv(data.ClientKey, "LIDARR_CLIENT_KEY"),
+		Timeout:            time.Duration(data.Timeout.ValueInt64()) * time.Second,
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	}
+
+	if env := os.Getenv("LIDARR_TIMEOUT"); data.Timeout.IsNull() && env != "" {
+		timeout, err := strconv.Atoi(env)
+		if err != nil || timeout < 0 {
+			return options, fmt.Errorf("LIDARR_TIMEOUT must be a non negative number of seconds, got %q", env)
+		}
+
+		options.Timeout = time.Duration(timeout) * time.Second
+	}
+
+	if env := os.Getenv("LIDARR_INSECURE_SKIP_VERIFY"); data.InsecureSkipVerify.IsNull() && env != "" {
+		insecure, err := strconv.ParseBool(env)
+		if err != nil {
+			return options, fmt.Errorf("LIDARR_INSECURE_SKIP_VERIFY must be a boolean, got %q", env)
+		}
+
+		options.InsecureSkipVerify = insecure
+	}
+
+	return options, nil
+}
+
+func boolValueOrEnv(value types.Bool, env string) (bool, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool(), nil
+	}
+
+	if os.Getenv(env) == "" {
+		return false, nil
+	}
+
+	result, err := strconv.ParseBool(os.Getenv(env))
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean, got %q", env, os.Getenv(env))
+	}
+
+	return result, nil
+}
+
+func stringValueOrEnv(value types.String, env string) string {
+	if value.ValueString() != "" {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
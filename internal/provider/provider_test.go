@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/testserver"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -17,6 +19,26 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"lidarr": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// TestMain starts the fake Lidarr API when acceptance tests are
+// requested without a live instance.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") != "" && os.Getenv("LIDARR_URL") == "" {
+		server := testserver.New("testAPIKey")
+		os.Setenv("LIDARR_URL", server.URL)
+		os.Setenv("LIDARR_API_KEY", server.APIKey)
+		os.Setenv(testServerEnv, "1")
+
+		testUnauthorizedProvider = testAccUnauthorizedProvider()
+
+		code := m.Run()
+
+		server.Close()
+		os.Exit(code)
+	}
+
+	os.Exit(m.Run())
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 
@@ -37,9 +59,13 @@ func testAccAPIClient() *lidarr.APIClient {
 	return lidarr.NewAPIClient(config)
 }
 
-const testUnauthorizedProvider = `
+// testUnauthorizedProvider points to the tested instance with an invalid API key.
+var testUnauthorizedProvider = testAccUnauthorizedProvider()
+
+func testAccUnauthorizedProvider() string {
+	return fmt.Sprintf(`
 provider "lidarr" {
-	url = "http://localhost:8686"
+	url = "%s"
 	api_key = "ErrorAPIKey"
 	extra_headers = [
 		{
@@ -48,4 +74,28 @@ provider "lidarr" {
 		}
 	]
   }
-`
+`, testAccUnauthorizedURL())
+}
+
+// testServerEnv is set when the tests run against the fake Lidarr API.
+const testServerEnv = "LIDARR_TEST_SERVER"
+
+// testAccLivePreCheck skips tests relying on behaviours the fake Lidarr API
+// does not implement, such as metadata lookups.
+func testAccLivePreCheck(t *testing.T) {
+	t.Helper()
+
+	testAccPreCheck(t)
+
+	if os.Getenv(testServerEnv) != "" {
+		t.Skip("test requires a live Lidarr instance")
+	}
+}
+
+func testAccUnauthorizedURL() string {
+	if v := os.Getenv("LIDARR_URL"); v != "" {
+		return v
+	}
+
+	return "http://localhost:8686"
+}
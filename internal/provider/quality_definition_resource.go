@@ -120,7 +120,7 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	// Read to get the quality ID
 	read, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(r.auth, request.GetId()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, qualityDefinitionResourceName, err, validationAttribute(qualityDefinitionResourceName))
 
 		return
 	}
@@ -130,7 +130,7 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	// Create new QualityDefinition
 	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, qualityDefinitionResourceName, err, validationAttribute(qualityDefinitionResourceName))
 
 		return
 	}
@@ -181,7 +181,7 @@ func (r *QualityDefinitionResource) Update(ctx context.Context, req resource.Upd
 	// Update QualityDefinition
 	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityDefinitionResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, qualityDefinitionResourceName, err, validationAttribute(qualityDefinitionResourceName))
 
 		return
 	}
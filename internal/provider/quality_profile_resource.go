@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"slices"
 	"strconv"
 
@@ -25,6 +26,7 @@ const qualityProfileResourceName = "quality_profile"
 var (
 	_ resource.Resource                = &QualityProfileResource{}
 	_ resource.ResourceWithImportState = &QualityProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &QualityProfileResource{}
 )
 
 func NewQualityProfileResource() resource.Resource {
@@ -35,6 +37,7 @@ func NewQualityProfileResource() resource.Resource {
 type QualityProfileResource struct {
 	client *lidarr.APIClient
 	auth   context.Context
+	data   *LidarrData
 }
 
 // QualityProfile describes the quality profile data model.
@@ -227,9 +230,14 @@ func (r *QualityProfileResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.data = resourceLidarrData(req)
 	}
 }
 
+func (r *QualityProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkVersionRequirements(ctx, req.Config, r.data, qualityProfileVersionRequirements, &resp.Diagnostics)
+}
+
 func (r *QualityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var profile *QualityProfile
@@ -246,7 +254,7 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 	// Create new QualityProfile
 	response, _, err := r.client.QualityProfileAPI.CreateQualityProfile(r.auth).QualityProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityProfileResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, qualityProfileResourceName, err, validationAttribute(qualityProfileResourceName))
 
 		return
 	}
@@ -268,8 +276,15 @@ func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get qualityprofile current value
-	response, _, err := r.client.QualityProfileAPI.GetQualityProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.QualityProfileAPI.GetQualityProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(qualityProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileResourceName, err))
 
 		return
@@ -297,7 +312,7 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 	// Update QualityProfile
 	response, _, err := r.client.QualityProfileAPI.UpdateQualityProfile(r.auth, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityProfileResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, qualityProfileResourceName, err, validationAttribute(qualityProfileResourceName))
 
 		return
 	}
@@ -330,7 +345,9 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *QualityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return qualityProfileIDByName(r.auth, r.client, name)
+	})
 	tflog.Trace(ctx, "imported "+qualityProfileResourceName+": "+req.ID)
 }
 
@@ -563,3 +580,13 @@ func (r QualityProfileResource) getFormatsIDs(diags *diag.Diagnostics) []int32 {
 
 	return formatIDs
 }
+
+// qualityProfileIDByName returns the ID of the quality profile with the given name.
+func qualityProfileIDByName(auth context.Context, client *lidarr.APIClient, name string) (int32, error) {
+	response, _, err := client.QualityProfileAPI.ListQualityProfile(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, qualityProfileResourceName, err))
+	}
+
+	return helpers.FindIDByName(qualityProfileResourceName, "name", name, response, (*lidarr.QualityProfileResource).GetName, (*lidarr.QualityProfileResource).GetId)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const refreshArtistActionName = "refresh_artist"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &RefreshArtistAction{}
+	_ action.ActionWithConfigure = &RefreshArtistAction{}
+)
+
+func NewRefreshArtistAction() action.Action {
+	return &RefreshArtistAction{}
+}
+
+// RefreshArtistAction defines the refresh artist implementation.
+type RefreshArtistAction struct {
+	commandAction
+}
+
+// RefreshArtist describes the refresh artist data model.
+type RefreshArtist struct {
+	ArtistIDs types.Set `tfsdk:"artist_ids"`
+	CommandActionOptions
+}
+
+func (a *RefreshArtistAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + refreshArtistActionName
+}
+
+func (a *RefreshArtistAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Artists -->\nRefresh Artist action, running the `RefreshArtist` command to refresh the artist information and rescan their files.\nFor more information refer to [Artists](https://wiki.servarr.com/lidarr/library#artists) documentation.",
+		Attributes: commandActionAttributes(map[string]schema.Attribute{
+			"artist_ids": schema.SetAttribute{
+				MarkdownDescription: "Artist IDs. Defaults to all the artists.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+		}),
+	}
+}
+
+func (a *RefreshArtistAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var refresh RefreshArtist
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &refresh)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var arguments map[string]any
+
+	if !refresh.ArtistIDs.IsNull() {
+		ids := make([]int64, 0, len(refresh.ArtistIDs.Elements()))
+		resp.Diagnostics.Append(refresh.ArtistIDs.ElementsAs(ctx, &ids, false)...)
+		arguments = map[string]any{"artistIds": ids}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a.invoke(ctx, "RefreshArtist", arguments, refresh.CommandActionOptions, resp)
+}
@@ -132,7 +132,7 @@ func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.Create
 	// Create new ReleaseProfile
 	response, _, err := r.client.ReleaseProfileAPI.CreateReleaseProfile(r.auth).ReleaseProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, releaseProfileResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, releaseProfileResourceName, err, validationAttribute(releaseProfileResourceName))
 
 		return
 	}
@@ -154,8 +154,15 @@ func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get releaseprofile current value
-	response, _, err := r.client.ReleaseProfileAPI.GetReleaseProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ReleaseProfileAPI.GetReleaseProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(releaseProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseProfileResourceName, err))
 
 		return
@@ -183,7 +190,7 @@ func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.Update
 	// Update ReleaseProfile
 	response, _, err := r.client.ReleaseProfileAPI.UpdateReleaseProfile(r.auth, strconv.Itoa(int(request.GetId()))).ReleaseProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, releaseProfileResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, releaseProfileResourceName, err, validationAttribute(releaseProfileResourceName))
 
 		return
 	}
@@ -105,7 +105,7 @@ func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.Cre
 
 	response, _, err := r.client.RemotePathMappingAPI.CreateRemotePathMapping(r.auth).RemotePathMappingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, remotePathMappingResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, remotePathMappingResourceName, err, validationAttribute(remotePathMappingResourceName))
 
 		return
 	}
@@ -127,8 +127,15 @@ func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get remotePathMapping current value
-	response, _, err := r.client.RemotePathMappingAPI.GetRemotePathMappingById(r.auth, int32(mapping.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RemotePathMappingAPI.GetRemotePathMappingById(r.auth, int32(mapping.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(remotePathMappingResourceName, mapping.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, remotePathMappingResourceName, err))
 
 		return
@@ -155,7 +162,7 @@ func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.Upd
 
 	response, _, err := r.client.RemotePathMappingAPI.UpdateRemotePathMapping(r.auth, strconv.Itoa(int(request.GetId()))).RemotePathMappingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, remotePathMappingResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, remotePathMappingResourceName, err, validationAttribute(remotePathMappingResourceName))
 
 		return
 	}
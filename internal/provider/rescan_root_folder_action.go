@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const rescanRootFolderActionName = "rescan_root_folder"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &RescanRootFolderAction{}
+	_ action.ActionWithConfigure = &RescanRootFolderAction{}
+)
+
+func NewRescanRootFolderAction() action.Action {
+	return &RescanRootFolderAction{}
+}
+
+// RescanRootFolderAction defines the rescan root folder implementation.
+type RescanRootFolderAction struct {
+	commandAction
+}
+
+// RescanRootFolder describes the rescan root folder data model.
+type RescanRootFolder struct {
+	Folders types.Set `tfsdk:"folders"`
+	CommandActionOptions
+}
+
+func (a *RescanRootFolderAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + rescanRootFolderActionName
+}
+
+func (a *RescanRootFolderAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->\nRescan Root Folder action, running the `RescanFolders` command to import the files added to the root folders.\nFor more information refer to [Root Folders](https://wiki.servarr.com/lidarr/settings#root-folders) documentation.",
+		Attributes: commandActionAttributes(map[string]schema.Attribute{
+			"folders": schema.SetAttribute{
+				MarkdownDescription: "Root folder paths. Defaults to all the root folders.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		}),
+	}
+}
+
+func (a *RescanRootFolderAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var rescan RescanRootFolder
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &rescan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var arguments map[string]any
+
+	if !rescan.Folders.IsNull() {
+		folders := make([]string, 0, len(rescan.Folders.Elements()))
+		resp.Diagnostics.Append(rescan.Folders.ElementsAs(ctx, &folders, false)...)
+		arguments = map[string]any{"folders": folders}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a.invoke(ctx, "RescanFolders", arguments, rescan.CommandActionOptions, resp)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
@@ -150,7 +151,7 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 
 	response, _, err := r.client.RootFolderAPI.CreateRootFolder(r.auth).RootFolderResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, rootFolderResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, rootFolderResourceName, err, validationAttribute(rootFolderResourceName))
 
 		return
 	}
@@ -172,8 +173,15 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get rootFolder current value
-	response, _, err := r.client.RootFolderAPI.GetRootFolderById(r.auth, int32(folder.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RootFolderAPI.GetRootFolderById(r.auth, int32(folder.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(rootFolderResourceName, folder.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderResourceName, err))
 
 		return
@@ -200,7 +208,7 @@ func (r *RootFolderResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	response, _, err := r.client.RootFolderAPI.UpdateRootFolder(r.auth, strconv.Itoa(int(request.GetId()))).RootFolderResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, rootFolderResourceName, err, validationAttribute(rootFolderResourceName))
 
 		return
 	}
@@ -233,7 +241,9 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *RootFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return rootFolderIDByName(r.auth, r.client, name)
+	})
 	tflog.Trace(ctx, "imported "+rootFolderResourceName+": "+req.ID)
 }
 
@@ -265,3 +275,13 @@ func (r *RootFolder) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.
 
 	return folder
 }
+
+// rootFolderIDByName returns the ID of the root folder with the given path.
+func rootFolderIDByName(auth context.Context, client *lidarr.APIClient, name string) (int32, error) {
+	response, _, err := client.RootFolderAPI.ListRootFolder(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, rootFolderResourceName, err))
+	}
+
+	return helpers.FindIDByName(rootFolderResourceName, "path", name, response, (*lidarr.RootFolderResource).GetPath, (*lidarr.RootFolderResource).GetId)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+const rssSyncActionName = "rss_sync"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &RssSyncAction{}
+	_ action.ActionWithConfigure = &RssSyncAction{}
+)
+
+func NewRssSyncAction() action.Action {
+	return &RssSyncAction{}
+}
+
+// RssSyncAction defines the RSS sync implementation.
+type RssSyncAction struct {
+	commandAction
+}
+
+func (a *RssSyncAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + rssSyncActionName
+}
+
+func (a *RssSyncAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nRSS Sync action, running the `RssSync` command to fetch the latest releases from the indexers.\nFor more information refer to [Tasks](https://wiki.servarr.com/lidarr/system#tasks) documentation.",
+		Attributes:          commandActionAttributes(map[string]schema.Attribute{}),
+	}
+}
+
+func (a *RssSyncAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var options CommandActionOptions
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &options)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a.invoke(ctx, "RssSync", nil, options, resp)
+}
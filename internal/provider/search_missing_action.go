@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+const searchMissingActionName = "search_missing"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &SearchMissingAction{}
+	_ action.ActionWithConfigure = &SearchMissingAction{}
+)
+
+func NewSearchMissingAction() action.Action {
+	return &SearchMissingAction{}
+}
+
+// SearchMissingAction defines the search missing implementation.
+type SearchMissingAction struct {
+	commandAction
+}
+
+func (a *SearchMissingAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + searchMissingActionName
+}
+
+func (a *SearchMissingAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nSearch Missing action, running the `MissingAlbumSearch` command to search the monitored albums without files.\nFor more information refer to [Wanted](https://wiki.servarr.com/lidarr/wanted) documentation.",
+		Attributes:          commandActionAttributes(map[string]schema.Attribute{}),
+	}
+}
+
+func (a *SearchMissingAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var options CommandActionOptions
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &options)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a.invoke(ctx, "MissingAlbumSearch", nil, options, resp)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -103,7 +104,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	response, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Create, tagResourceName, err, validationAttribute(tagResourceName))
 
 		return
 	}
@@ -125,8 +126,15 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get tag current value
-	response, _, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp, err) {
+			tflog.Warn(ctx, helpers.ParseRemoveFromStateWarning(tagResourceName, tag.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
 
 		return
@@ -153,7 +161,7 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	response, _, err := r.client.TagAPI.UpdateTag(r.auth, fmt.Sprint(tagResource.GetId())).TagResource(*tagResource).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, tagResourceName, err))
+		helpers.AddClientError(&resp.Diagnostics, helpers.Update, tagResourceName, err, validationAttribute(tagResourceName))
 
 		return
 	}
@@ -186,7 +194,9 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, func(name string) (int32, error) {
+		return tagIDByName(r.auth, r.client, name)
+	})
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
 
@@ -202,3 +212,13 @@ func (t *Tag) read() *lidarr.TagResource {
 
 	return tag
 }
+
+// tagIDByName returns the ID of the tag with the given label.
+func tagIDByName(auth context.Context, client *lidarr.APIClient, name string) (int32, error) {
+	response, _, err := client.TagAPI.ListTag(auth).Execute()
+	if err != nil {
+		return 0, errors.New(helpers.ParseClientError(helpers.List, tagResourceName, err))
+	}
+
+	return helpers.FindIDByName(tagResourceName, "label", name, response, (*lidarr.TagResource).GetLabel, (*lidarr.TagResource).GetId)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -47,6 +48,22 @@ func TestAccTagResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "lidarr_tag.test",
+				ImportState:       true,
+				ImportStateId:     "name:hvec",
+				ImportStateVerify: true,
+			},
+			// Out of band deletion
+			{
+				PreConfig: func() { tagDeleteByLabel("hvec") },
+				Config:    testAccTagResourceConfig("test", "hvec"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_tag.test", "label", "hvec"),
+					resource.TestCheckResourceAttrSet("lidarr_tag.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -59,3 +76,15 @@ func testAccTagResourceConfig(name, label string) string {
 		}
 	`, name, label)
 }
+
+func tagDeleteByLabel(label string) {
+	// remove the tag outside of terraform
+	client := testAccAPIClient()
+	tags, _, _ := client.TagAPI.ListTag(context.TODO()).Execute()
+
+	for _, tag := range tags {
+		if tag.GetLabel() == label {
+			_, _ = client.TagAPI.DeleteTag(context.TODO(), tag.GetId()).Execute()
+		}
+	}
+}
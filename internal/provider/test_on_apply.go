@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testOnApplyAttribute returns the schema of the test_on_apply attribute.
+func testOnApplyAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Test the connection before saving, as the Lidarr UI does. Defaults to the provider `test_on_apply` value.",
+		Optional:            true,
+	}
+}
+
+// resourceTestOnApply returns the provider default of the test_on_apply attribute.
+func resourceTestOnApply(req resource.ConfigureRequest) bool {
+	if data, ok := req.ProviderData.(*LidarrData); ok {
+		return data.TestOnApply
+	}
+
+	return false
+}
+
+// isTestOnApply identifies whether the connection must be tested, falling back to the provider default.
+func isTestOnApply(value types.Bool, providerDefault bool) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return providerDefault
+	}
+
+	return value.ValueBool()
+}
@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestOnApply(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isTestOnApply(types.BoolNull(), true))
+	assert.False(t, isTestOnApply(types.BoolValue(false), true))
+	assert.True(t, isTestOnApply(types.BoolValue(true), false))
+	assert.False(t, isTestOnApply(types.BoolUnknown(), false))
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// fieldPropertyRegexp matches the validation properties referring to a field by index, e.g. Fields[2].Value.
+var fieldPropertyRegexp = regexp.MustCompile(`(?i)^fields\[(\d+)\]`)
+
+var (
+	// resourceAttributes contains the attribute names of each resource schema, by resource name (e.g. `tag`).
+	resourceAttributes     map[string]map[string]bool
+	resourceAttributesOnce sync.Once
+)
+
+// schemaAttribute returns the path of the attribute, if it is defined by the schema of the named resource.
+func schemaAttribute(resourceName, attribute string) (path.Path, bool) {
+	resourceAttributesOnce.Do(func() {
+		ctx := context.Background()
+		resourceAttributes = make(map[string]map[string]bool)
+
+		for _, newResource := range New("")().Resources(ctx) {
+			r := newResource()
+
+			metadataResp := resource.MetadataResponse{}
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "lidarr"}, &metadataResp)
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			attributes := make(map[string]bool, len(schemaResp.Schema.Attributes))
+			for name := range schemaResp.Schema.Attributes {
+				attributes[name] = true
+			}
+
+			resourceAttributes[strings.TrimPrefix(metadataResp.TypeName, "lidarr_")] = attributes
+		}
+	})
+
+	if !resourceAttributes[resourceName][attribute] {
+		return path.Empty(), false
+	}
+
+	return path.Root(attribute), true
+}
+
+// validationAttribute returns a function mapping the property of a Lidarr validation failure to the attribute
+// of the named resource, converting it to snake case, e.g. QualityProfileId to quality_profile_id.
+// Properties without a matching attribute in the resource schema are not mapped.
+func validationAttribute(resourceName string) func(string) (path.Path, bool) {
+	return func(property string) (path.Path, bool) {
+		if property == "" || strings.ContainsAny(property, ".[") {
+			return path.Empty(), false
+		}
+
+		return schemaAttribute(resourceName, snakeCase(property))
+	}
+}
+
+// noValidationAttribute does not map any validation failure property, e.g. for actions.
+func noValidationAttribute(string) (path.Path, bool) {
+	return path.Empty(), false
+}
+
+// fieldValidationAttribute returns a function mapping the properties of Lidarr validation failures to the attributes
+// of the named resource with fields. Settings properties, e.g. BaseUrl, and fields referenced by index are mapped through
+// the field names, fields without an attribute to the free-form fields.
+func fieldValidationAttribute(resourceName string, fieldNames map[string]string, fields []lidarr.Field) func(string) (path.Path, bool) {
+	return func(property string) (path.Path, bool) {
+		if match := fieldPropertyRegexp.FindStringSubmatch(property); match != nil {
+			index, _ := strconv.Atoi(match[1])
+			if index >= len(fields) {
+				return path.Empty(), false
+			}
+
+			if attribute, ok := fieldNames[fields[index].GetName()]; ok {
+				return schemaAttribute(resourceName, attribute)
+			}
+
+			if _, ok := schemaAttribute(resourceName, "fields"); !ok {
+				return path.Empty(), false
+			}
+
+			return path.Root("fields").AtMapKey(fields[index].GetName()), true
+		}
+
+		for name, attribute := range fieldNames {
+			if strings.EqualFold(name, property) {
+				return schemaAttribute(resourceName, attribute)
+			}
+		}
+
+		return validationAttribute(resourceName)(property)
+	}
+}
+
+// snakeCase converts a Lidarr property name, e.g. EnableRss, to snake case.
+func snakeCase(name string) string {
+	var output strings.Builder
+
+	for i, r := range name {
+		if unicode.IsUpper(r) && i > 0 {
+			output.WriteRune('_')
+		}
+
+		output.WriteRune(unicode.ToLower(r))
+	}
+
+	return output.String()
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationAttribute(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resource string
+		property string
+		expected path.Path
+		found    bool
+	}{
+		"property":         {resource: artistResourceName, property: "QualityProfileId", expected: path.Root("quality_profile_id"), found: true},
+		"not an attribute": {resource: tagResourceName, property: "QualityProfileId", expected: path.Empty(), found: false},
+		"unknown resource": {resource: "unknown", property: "Name", expected: path.Empty(), found: false},
+		"nested":           {resource: artistResourceName, property: "Items[0].Allowed", expected: path.Empty(), found: false},
+		"empty":            {resource: artistResourceName, property: "", expected: path.Empty(), found: false},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attribute, found := validationAttribute(test.resource)(test.property)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, attribute)
+		})
+	}
+}
+
+func TestFieldValidationAttribute(t *testing.T) {
+	t.Parallel()
+
+	fields := []lidarr.Field{
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("baseUrl"))},
+		{Name: *lidarr.NewNullableString(lidarr.PtrString("rejectBlocklistedTorrentHashesWhileGrabbing"))},
+	}
+
+	tests := map[string]struct {
+		resource string
+		property string
+		expected path.Path
+		found    bool
+	}{
+		"field":               {resource: indexerResourceName, property: "BaseUrl", expected: path.Root("base_url"), found: true},
+		"renamed field":       {resource: indexerResourceName, property: "SeedCriteria.SeedTime", expected: path.Root("seed_time"), found: true},
+		"indexed field":       {resource: indexerResourceName, property: "fields[0].value", expected: path.Root("base_url"), found: true},
+		"free field":          {resource: indexerResourceName, property: "Fields[1].Value", expected: path.Root("fields").AtMapKey("rejectBlocklistedTorrentHashesWhileGrabbing"), found: true},
+		"missing field":       {resource: indexerResourceName, property: "fields[5].value", expected: path.Empty(), found: false},
+		"property":            {resource: indexerResourceName, property: "EnableRss", expected: path.Root("enable_rss"), found: true},
+		"nested unknown":      {resource: indexerResourceName, property: "Settings.Other", expected: path.Empty(), found: false},
+		"empty":               {resource: indexerResourceName, property: "", expected: path.Empty(), found: false},
+		"specific field":      {resource: indexerNewznabResourceName, property: "BaseUrl", expected: path.Root("base_url"), found: true},
+		"specific no field":   {resource: indexerNewznabResourceName, property: "SeedCriteria.SeedTime", expected: path.Empty(), found: false},
+		"specific free field": {resource: indexerNewznabResourceName, property: "Fields[1].Value", expected: path.Empty(), found: false},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attribute, found := fieldValidationAttribute(test.resource, indexerFieldNames, fields)(test.property)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, attribute)
+		})
+	}
+}
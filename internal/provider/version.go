@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// versionRequirement describes an attribute requiring a minimum Lidarr version.
+type versionRequirement struct {
+	attribute string
+	version   string
+}
+
+// Lidarr versions introducing the attributes not supported by older builds.
+var (
+	notificationVersionRequirements = []versionRequirement{
+		// Health issue notifications, with their includeHealthWarnings flag, came with the Lidarr v0.6.0 release.
+		{attribute: "include_health_warnings", version: "0.6.0.0"},
+	}
+	qualityProfileVersionRequirements = []versionRequirement{
+		// Custom formats and their profile scores are part of the Lidarr v1.1.4 API,
+		// see lidarr-go v0.2.0 "add custom format" (74b2cb4) and "align with v1.1.4" (4b88b1e).
+		{attribute: "format_items", version: "1.1.4.0"},
+		{attribute: "min_format_score", version: "1.1.4.0"},
+		{attribute: "cutoff_format_score", version: "1.1.4.0"},
+	}
+)
+
+// Version returns the Lidarr version, fetched from the system status on first use
+// and kept for the lifetime of the provider configuration.
+// It is nil when the version cannot be determined.
+func (d *LidarrData) Version() *version.Version {
+	d.versionOnce.Do(func() {
+		status, _, err := d.Client.SystemAPI.GetSystemStatus(d.Auth).Execute()
+		if err != nil {
+			tflog.Warn(d.Auth, "unable to check the Lidarr version: "+helpers.ParseClientError(helpers.Read, systemStatusDataSourceName, err))
+
+			return
+		}
+
+		d.setVersion(status)
+	})
+
+	return d.version
+}
+
+// setVersion parses the version of the given system status.
+func (d *LidarrData) setVersion(status *lidarr.SystemResource) {
+	parsed, err := version.NewVersion(status.GetVersion())
+	if err != nil {
+		tflog.Warn(d.Auth, fmt.Sprintf("unable to parse the Lidarr version %q: %s", status.GetVersion(), err))
+
+		return
+	}
+
+	d.version = parsed
+}
+
+// resourceLidarrData returns the provider data, nil when the provider is not configured.
+func resourceLidarrData(req resource.ConfigureRequest) *LidarrData {
+	data, _ := req.ProviderData.(*LidarrData)
+
+	return data
+}
+
+// checkVersionRequirements reports the configured attributes not supported by the Lidarr version.
+// Non-zero values are rejected, while zero values are only warned about since older builds ignore them.
+// The version is only fetched when one of the attributes is configured.
+func checkVersionRequirements(ctx context.Context, config tfsdk.Config, data *LidarrData, requirements []versionRequirement, diags *diag.Diagnostics) {
+	if data == nil || config.Raw.IsNull() {
+		return
+	}
+
+	for _, requirement := range requirements {
+		var value attr.Value
+
+		diags.Append(config.GetAttribute(ctx, path.Root(requirement.attribute), &value)...)
+
+		if value == nil || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		current := data.Version()
+		if current == nil || !current.LessThan(version.Must(version.NewVersion(requirement.version))) {
+			continue
+		}
+
+		summary := "Unsupported Lidarr version"
+		detail := fmt.Sprintf("Attribute '%s' requires Lidarr %s or newer, the server reports %s.", requirement.attribute, requirement.version, current.Original())
+
+		if isZeroValue(value) {
+			diags.AddAttributeWarning(path.Root(requirement.attribute), summary, detail+" The value is ignored.")
+
+			continue
+		}
+
+		diags.AddAttributeError(path.Root(requirement.attribute), summary, detail)
+	}
+}
+
+// isZeroValue identifies the values an older Lidarr can safely ignore.
+func isZeroValue(value attr.Value) bool {
+	switch v := value.(type) {
+	case types.Bool:
+		return !v.ValueBool()
+	case types.Int64:
+		return v.ValueInt64() == 0
+	case types.String:
+		return v.ValueString() == ""
+	case types.Set:
+		return len(v.Elements()) == 0
+	case types.List:
+		return len(v.Elements()) == 0
+	default:
+		return false
+	}
+}
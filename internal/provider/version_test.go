@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/testserver"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckVersionRequirements(t *testing.T) {
+	t.Parallel()
+
+	requirements := []versionRequirement{
+		{attribute: "flag", version: "2.0.0.0"},
+		{attribute: "score", version: "1.1.0.0"},
+	}
+
+	tests := map[string]struct {
+		version  string
+		flag     any
+		score    any
+		errors   int
+		warnings int
+	}{
+		"supported": {
+			version: "2.5.3.4341",
+			flag:    true,
+			score:   10,
+		},
+		"unsupported": {
+			version: "1.0.2.2592",
+			flag:    true,
+			score:   10,
+			errors:  2,
+		},
+		"zero values": {
+			version:  "1.0.2.2592",
+			flag:     false,
+			score:    0,
+			warnings: 2,
+		},
+		"not configured": {
+			version: "1.0.2.2592",
+		},
+		"unknown": {
+			version: "1.0.2.2592",
+			flag:    tftypes.UnknownValue,
+		},
+		"partially supported": {
+			version: "1.1.0.2649",
+			flag:    true,
+			score:   10,
+			errors:  1,
+		},
+		"unknown version": {
+			flag:  true,
+			score: 10,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &LidarrData{}
+			data.versionOnce.Do(func() {
+				if test.version != "" {
+					data.version = version.Must(version.NewVersion(test.version))
+				}
+			})
+
+			var diags diag.Diagnostics
+
+			checkVersionRequirements(context.Background(), testVersionConfig(test.flag, test.score), data, requirements, &diags)
+			assert.Equal(t, test.errors, diags.ErrorsCount(), diags)
+			assert.Equal(t, test.warnings, diags.WarningsCount(), diags)
+		})
+	}
+}
+
+func TestVersionRequirementBoundaries(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requirements []versionRequirement
+		below        string
+		minimum      string
+	}{
+		"notification": {
+			requirements: notificationVersionRequirements,
+			below:        "0.5.5.1020",
+			minimum:      "0.6.0.0",
+		},
+		"quality profile": {
+			requirements: qualityProfileVersionRequirements,
+			below:        "1.1.3.2982",
+			minimum:      "1.1.4.0",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, requirement := range test.requirements {
+				// Check each requirement on the generic flag attribute.
+				requirements := []versionRequirement{{attribute: "flag", version: requirement.version}}
+
+				for current, errors := range map[string]int{test.below: 1, test.minimum: 0} {
+					data := &LidarrData{}
+					data.versionOnce.Do(func() { data.version = version.Must(version.NewVersion(current)) })
+
+					var diags diag.Diagnostics
+
+					checkVersionRequirements(context.Background(), testVersionConfig(true, nil), data, requirements, &diags)
+					assert.Equal(t, errors, diags.ErrorsCount(), requirement.attribute+" on "+current)
+				}
+			}
+		})
+	}
+}
+
+func testVersionConfig(flag, score any) tfsdk.Config {
+	return tfsdk.Config{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"flag":  schema.BoolAttribute{Optional: true},
+				"score": schema.Int64Attribute{Optional: true},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"flag":  tftypes.Bool,
+			"score": tftypes.Number,
+		}}, map[string]tftypes.Value{
+			"flag":  tftypes.NewValue(tftypes.Bool, flag),
+			"score": tftypes.NewValue(tftypes.Number, score),
+		}),
+	}
+}
+
+func TestLidarrDataVersion(t *testing.T) {
+	t.Parallel()
+
+	server := testserver.New("key")
+	t.Cleanup(server.Close)
+
+	apiURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	config := lidarr.NewConfiguration()
+	config.HTTPClient = server.Client()
+	client := lidarr.NewAPIClient(config)
+
+	data := &LidarrData{Auth: helpers.NewAuthContext(context.Background(), apiURL, server.APIKey), Client: client}
+	assert.Equal(t, "2.5.3.4341", data.Version().Original())
+
+	unauthorized := &LidarrData{Auth: helpers.NewAuthContext(context.Background(), apiURL, "wrong"), Client: client}
+	assert.Nil(t, unauthorized.Version())
+}
+
+func TestIsZeroValue(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isZeroValue(types.BoolValue(false)))
+	assert.False(t, isZeroValue(types.BoolValue(true)))
+	assert.True(t, isZeroValue(types.Int64Value(0)))
+	assert.False(t, isZeroValue(types.Int64Value(-1)))
+	assert.True(t, isZeroValue(types.StringValue("")))
+	assert.True(t, isZeroValue(types.SetValueMust(types.Int64Type, nil)))
+	assert.False(t, isZeroValue(types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)})))
+}
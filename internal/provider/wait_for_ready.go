@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// waitForReady polls the given status call until it succeeds or the timeout expires.
+// A rejected API key is reported immediately, since waiting will not fix it.
+func waitForReady(ctx context.Context, timeout, interval time.Duration, status func(context.Context) (*http.Response, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for attempt := 1; ; attempt++ {
+		response, err := status(ctx)
+		if err == nil {
+			tflog.Debug(ctx, fmt.Sprintf("Lidarr ready after %d attempts", attempt))
+
+			return nil
+		}
+
+		if response != nil && (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) {
+			return errors.New(helpers.ParseClientError(helpers.Read, systemStatusDataSourceName, err))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Lidarr not ready, attempt %d: %s", attempt, err))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s and %d attempts waiting for Lidarr, last error: %w", timeout, attempt, err)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// readyRoundTripper waits for Lidarr to be ready before the first request, so that
+// configuring the provider does not call the API. The outcome is kept for all the requests.
+type readyRoundTripper struct {
+	next http.RoundTripper
+	wait func() error
+	once sync.Once
+	err  error
+}
+
+func (t *readyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.once.Do(func() { t.err = t.wait() })
+
+	if t.err != nil {
+		return nil, fmt.Errorf("wait for ready: %w", t.err)
+	}
+
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccProviderWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized fails fast
+			{
+				Config:      testAccProviderWaitForReadyConfig(`api_key = "ErrorAPIKey"`),
+				ExpectError: regexp.MustCompile(`wait\s+for\s+ready`),
+			},
+			// Ready
+			{
+				Config: testAccProviderWaitForReadyConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lidarr_system_status.test", "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderWaitForReadyAttempts(t *testing.T) {
+	t.Parallel()
+
+	// Lidarr still starting
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Each attempt is a single request, not retried
+			{
+				Config: fmt.Sprintf(`
+				provider "lidarr" {
+					url            = "%s"
+					max_retries    = 3
+					retry_wait_min = 5
+
+					wait_for_ready {
+						timeout  = 3
+						interval = 1
+					}
+				}
+
+				data "lidarr_system_status" "test" {
+				}`, server.URL),
+				ExpectError: regexp.MustCompile(`timed out\s+after\s+3s\s+and\s+[34]\s+attempts`),
+			},
+		},
+	})
+}
+
+func TestProviderConfigureWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"2.5.3.4341"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	values["url"] = tftypes.NewValue(tftypes.String, server.URL)
+	values["api_key"] = tftypes.NewValue(tftypes.String, "key")
+	values["wait_for_ready"] = tftypes.NewValue(objectType.AttributeTypes["wait_for_ready"], map[string]tftypes.Value{
+		"timeout":  tftypes.NewValue(tftypes.Number, 5),
+		"interval": tftypes.NewValue(tftypes.Number, 1),
+	})
+
+	var resp provider.ConfigureResponse
+
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, int32(0), requests.Load())
+
+	// The first call waits for Lidarr, the next ones do not
+	data := resp.ResourceData.(*LidarrData)
+	for range 2 {
+		_, _, err := data.Client.SystemAPI.GetSystemStatus(data.Auth).Execute()
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func testAccProviderWaitForReadyConfig(key string) string {
+	return fmt.Sprintf(`
+	provider "lidarr" {
+		%s
+
+		wait_for_ready {
+			timeout  = 30
+			interval = 1
+		}
+	}
+
+	data "lidarr_system_status" "test" {
+	}`, key)
+}
+
+func TestWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	notReady := errors.New("503 Service Unavailable")
+
+	tests := map[string]struct {
+		responses []int
+		attempts  int
+		err       string
+	}{
+		"ready": {
+			responses: []int{http.StatusOK},
+			attempts:  1,
+		},
+		"starting": {
+			responses: []int{0, http.StatusServiceUnavailable, http.StatusOK},
+			attempts:  3,
+		},
+		"unauthorized": {
+			responses: []int{http.StatusUnauthorized},
+			attempts:  1,
+			err:       "Unable to read system_status",
+		},
+		"timeout": {
+			responses: []int{http.StatusServiceUnavailable},
+			err:       "timed out after 50ms",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attempts := 0
+			err := waitForReady(context.Background(), 50*time.Millisecond, 10*time.Millisecond, func(context.Context) (*http.Response, error) {
+				code := test.responses[min(attempts, len(test.responses)-1)]
+				attempts++
+
+				switch code {
+				case http.StatusOK:
+					return &http.Response{StatusCode: code}, nil
+				case 0:
+					// Connection refused
+					return nil, notReady
+				default:
+					return &http.Response{StatusCode: code}, notReady
+				}
+			})
+
+			if test.err == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, test.attempts, attempts)
+
+				return
+			}
+
+			assert.ErrorContains(t, err, test.err)
+
+			if test.attempts > 0 {
+				assert.Equal(t, test.attempts, attempts)
+			}
+		})
+	}
+}
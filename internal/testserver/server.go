@@ -0,0 +1,545 @@
+// Package testserver implements an in-memory fake of the Lidarr API,
+// used to run the acceptance tests without a live instance.
+package testserver
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	pathpkg "path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	apiPrefix   = "/api/v1/"
+	maskedValue = "********"
+)
+
+// data holds the objects shipped with a fresh Lidarr install and the
+// schemas of the provider implementations.
+//
+//go:embed data
+var data embed.FS
+
+// collections are the endpoints implementing CRUD operations.
+var collections = map[string]bool{
+	"customformat":        true,
+	"delayprofile":        true,
+	"downloadclient":      true,
+	"importlist":          true,
+	"importlistexclusion": true,
+	"indexer":             true,
+	"metadata":            true,
+	"metadataprofile":     true,
+	"notification":        true,
+	"qualityprofile":      true,
+	"releaseprofile":      true,
+	"remotepathmapping":   true,
+	"rootfolder":          true,
+	"qualitydefinition":   true,
+	"tag":                 true,
+	"artist":              true,
+}
+
+// providers are the collections whose objects are completed with their implementation schema.
+var providers = map[string]bool{
+	"downloadclient": true,
+	"importlist":     true,
+	"indexer":        true,
+	"metadata":       true,
+	"notification":   true,
+}
+
+// configs are the singleton config endpoints.
+var configs = map[string]bool{
+	"downloadclient":   true,
+	"host":             true,
+	"indexer":          true,
+	"mediamanagement":  true,
+	"metadataprovider": true,
+	"naming":           true,
+	"ui":               true,
+}
+
+type object = map[string]any
+
+// seed describes the initial content of the fake API.
+type seed struct {
+	Collections map[string][]object `json:"collections"`
+	Static      map[string]any      `json:"static"`
+	Artists     map[string]object   `json:"artists"`
+}
+
+// Server is a fake Lidarr API storing resources in memory.
+type Server struct {
+	*httptest.Server
+	APIKey  string
+	mu      sync.Mutex
+	lastID  map[string]int
+	objects map[string]map[int]object
+	configs map[string]object
+	static  map[string]any
+	artists map[string]object
+	schemas map[string][]object
+}
+
+// New starts a fake Lidarr API accepting the given API key.
+func New(apiKey string) *Server {
+	s := &Server{
+		APIKey:  apiKey,
+		lastID:  make(map[string]int),
+		objects: make(map[string]map[int]object),
+		configs: make(map[string]object),
+		schemas: make(map[string][]object),
+	}
+
+	for name := range collections {
+		s.objects[name] = make(map[int]object)
+	}
+
+	s.objects["command"] = make(map[int]object)
+
+	var initial seed
+
+	mustDecode("data/seed.json", &initial)
+
+	for name, objects := range initial.Collections {
+		for _, o := range objects {
+			id := int(o["id"].(float64))
+			o["id"] = id
+			s.objects[name][id] = o
+			s.lastID[name] = max(s.lastID[name], id)
+		}
+	}
+
+	s.static = initial.Static
+	s.artists = initial.Artists
+
+	for name := range providers {
+		var schemas []object
+
+		mustDecode("data/schema/"+name+".json", &schemas)
+		s.schemas[name] = schemas
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Api-Key") != s.APIKey && r.URL.Query().Get("apikey") != s.APIKey {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, apiPrefix) {
+		writeError(w, http.StatusNotFound, "NotFound")
+
+		return
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/"), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && s.static[strings.Join(segments, "/")] != nil:
+		writeJSON(w, http.StatusOK, s.static[strings.Join(segments, "/")])
+	case providers[segments[0]] && len(segments) == 2 && segments[1] == "schema":
+		writeJSON(w, http.StatusOK, s.schemas[segments[0]])
+	case providers[segments[0]] && len(segments) == 2 && segments[1] == "test" && r.Method == http.MethodPost:
+		s.handleTest(w, r)
+	case segments[0] == "command" && len(segments) <= 2:
+		s.handleCommand(w, r, segments[1:])
+	case segments[0] == "config" && len(segments) > 1 && configs[segments[1]]:
+		s.handleConfig(w, r, segments[1])
+	case collections[segments[0]] && len(segments) == 1:
+		s.handleCollection(w, r, segments[0])
+	case collections[segments[0]] && len(segments) == 2:
+		s.handleObject(w, r, segments[0], segments[1])
+	default:
+		writeError(w, http.StatusNotFound, "NotFound")
+	}
+}
+
+func (s *Server) handleConfig(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.config(name))
+	case http.MethodPut:
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+
+		body["id"] = 1
+		s.configs[name] = body
+		writeJSON(w, http.StatusAccepted, body)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *Server) handleCollection(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.list(name, r.URL.Query()))
+	case http.MethodPost:
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+
+		if providers[name] && !validateProvider(w, r, body) {
+			return
+		}
+
+		if name == "artist" {
+			body = s.completeArtist(body, nil)
+		}
+
+		writeJSON(w, http.StatusCreated, s.mask(name, s.create(name, s.complete(name, body, nil))))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *Server) handleObject(w http.ResponseWriter, r *http.Request, name, rawID string) {
+	id, err := strconv.Atoi(rawID)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "NotFound")
+
+		return
+	}
+
+	if _, ok := s.objects[name][id]; !ok {
+		writeError(w, http.StatusNotFound, "NotFound")
+
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.mask(name, s.objects[name][id]))
+	case http.MethodPut:
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+
+		if providers[name] && !validateProvider(w, r, body) {
+			return
+		}
+
+		body["id"] = id
+
+		if name == "artist" {
+			body = s.completeArtist(body, s.objects[name][id])
+		}
+
+		s.objects[name][id] = s.complete(name, body, s.objects[name][id])
+		writeJSON(w, http.StatusAccepted, s.mask(name, body))
+	case http.MethodDelete:
+		delete(s.objects[name], id)
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+// handleCommand queues the posted commands and runs them when they are read back,
+// completing them unless their name starts with "Invalid".
+func (s *Server) handleCommand(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case r.Method == http.MethodPost && len(segments) == 0:
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+
+		command := object{"name": body["name"], "commandName": body["name"], "body": body, "status": "queued", "queued": time.Now().UTC().Format(time.RFC3339), "trigger": "manual"}
+		writeJSON(w, http.StatusCreated, s.create("command", command))
+	case r.Method == http.MethodGet && len(segments) == 1:
+		id, _ := strconv.Atoi(segments[0])
+
+		command, ok := s.objects["command"][id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NotFound")
+
+			return
+		}
+
+		if command["status"] == "queued" {
+			command["status"], command["message"] = "completed", "Completed"
+			if strings.HasPrefix(fmt.Sprint(command["name"]), "Invalid") {
+				command["status"], command["message"] = "failed", "Unknown command"
+			}
+
+			command["duration"] = "00:00:00.0100000"
+		}
+
+		writeJSON(w, http.StatusOK, command)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+// handleTest simulates a connection test, failing for each field whose
+// value starts with "invalid" and warning for the ones starting with "warn".
+func (s *Server) handleTest(w http.ResponseWriter, r *http.Request) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	failures := fieldFailures(body, true)
+	if len(failures) > 0 {
+		writeJSON(w, http.StatusBadRequest, failures)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// validateProvider simulates the validation run by Lidarr when saving a provider:
+// the name is required and fields starting with "warn" raise a warning, unless forceSave is set.
+// It writes the failures and returns false when the provider must not be saved.
+func validateProvider(w http.ResponseWriter, r *http.Request, body object) bool {
+	failures := []object{}
+
+	if name, _ := body["name"].(string); name == "" {
+		failures = append(failures, object{"propertyName": "Name", "errorMessage": "'Name' must not be empty.", "attemptedValue": "", "severity": "error", "isWarning": false})
+	}
+
+	if r.URL.Query().Get("forceSave") != "true" {
+		failures = append(failures, fieldFailures(body, false)...)
+	}
+
+	if len(failures) > 0 {
+		writeJSON(w, http.StatusBadRequest, failures)
+
+		return false
+	}
+
+	return true
+}
+
+// fieldFailures returns the warnings for the field values starting with "warn"
+// and, on connection, the errors for the ones starting with "invalid".
+func fieldFailures(body object, connect bool) []object {
+	failures := []object{}
+
+	fields, _ := body["fields"].([]any)
+	for i, f := range fields {
+		field, _ := f.(object)
+		value, _ := field["value"].(string)
+		name := propertyName(fmt.Sprint(field["name"]))
+
+		switch {
+		case connect && strings.HasPrefix(value, "invalid"):
+			failures = append(failures, object{"propertyName": name, "errorMessage": "Unable to connect", "attemptedValue": value, "severity": "error", "isWarning": false})
+		case connect && strings.HasPrefix(value, "warn"):
+			failures = append(failures, object{"propertyName": name, "errorMessage": "Connection is slow", "attemptedValue": value, "severity": "warning", "isWarning": true})
+		case strings.HasPrefix(value, "warn"):
+			failures = append(failures, object{"propertyName": fmt.Sprintf("Fields[%d].Value", i), "errorMessage": "Value is deprecated", "attemptedValue": value, "severity": "warning", "isWarning": true})
+		}
+	}
+
+	return failures
+}
+
+// propertyName returns the name Lidarr uses in validation failures for a field, e.g. SeedCriteria.SeedTime.
+func propertyName(field string) string {
+	segments := strings.Split(field, ".")
+	for i, segment := range segments {
+		if segment != "" {
+			segments[i] = strings.ToUpper(segment[:1]) + segment[1:]
+		}
+	}
+
+	return strings.Join(segments, ".")
+}
+
+func (s *Server) config(name string) object {
+	if config, ok := s.configs[name]; ok {
+		return config
+	}
+
+	return object{"id": 1}
+}
+
+func (s *Server) list(name string, query url.Values) []object {
+	output := make([]object, 0, len(s.objects[name]))
+
+	for _, o := range s.objects[name] {
+		if name == "artist" && query.Has("mbId") && o["foreignArtistId"] != query.Get("mbId") {
+			continue
+		}
+
+		output = append(output, s.mask(name, o))
+	}
+
+	sort.Slice(output, func(i, j int) bool { return output[i]["id"].(int) < output[j]["id"].(int) })
+
+	return output
+}
+
+func (s *Server) create(name string, body object) object {
+	s.lastID[name]++
+	body["id"] = s.lastID[name]
+	s.objects[name][s.lastID[name]] = body
+
+	return body
+}
+
+// complete adds the fields missing from a provider object with their default
+// values, as Lidarr does, and keeps the stored secrets sent back masked.
+func (s *Server) complete(name string, body, previous object) object {
+	schema := s.schema(name, body["implementation"])
+	if schema == nil {
+		return body
+	}
+
+	fields, _ := body["fields"].([]any)
+
+	for _, f := range schema["fields"].([]any) {
+		field := f.(object)
+
+		sent := findField(fields, field["name"])
+		if sent == nil {
+			fields = append(fields, object{"name": field["name"], "value": field["value"]})
+
+			continue
+		}
+
+		// Like Lidarr, the mask is only swapped for the stored secret of an existing provider:
+		// on creation it is saved as the secret itself.
+		if field["privacy"] != nil && sent["value"] == maskedValue && previous != nil {
+			sent["value"] = ""
+
+			if stored := findField(previous["fields"].([]any), field["name"]); stored != nil {
+				sent["value"] = stored["value"]
+			}
+		}
+	}
+
+	body["fields"] = fields
+
+	return body
+}
+
+// completeArtist fills the artist metadata and paths, as Lidarr does
+// when adding an artist from MusicBrainz.
+func (s *Server) completeArtist(body, previous object) object {
+	for key, value := range s.artists[fmt.Sprint(body["foreignArtistId"])] {
+		if key != "artistName" || body[key] == nil {
+			body[key] = value
+		}
+	}
+
+	if path, _ := body["path"].(string); path == "" {
+		body["path"] = strings.TrimRight(fmt.Sprint(body["rootFolderPath"]), "/") + "/" + fmt.Sprint(body["artistName"])
+	}
+
+	body["rootFolderPath"] = pathpkg.Dir(body["path"].(string))
+	body["folder"] = pathpkg.Base(body["path"].(string))
+	body["added"] = time.Now().UTC().Format(time.RFC3339)
+
+	if previous != nil {
+		body["added"] = previous["added"]
+	}
+
+	return body
+}
+
+// mask returns a copy of the object with the secret fields masked.
+func (s *Server) mask(name string, o object) object {
+	schema := s.schema(name, o["implementation"])
+	if schema == nil {
+		return o
+	}
+
+	output := make(object, len(o))
+	for key, value := range o {
+		output[key] = value
+	}
+
+	fields := make([]any, 0, len(o["fields"].([]any)))
+
+	for _, f := range o["fields"].([]any) {
+		field := f.(object)
+
+		if definition := findField(schema["fields"].([]any), field["name"]); definition != nil && definition["privacy"] != nil && field["value"] != "" {
+			field = object{"name": field["name"], "value": maskedValue, "privacy": definition["privacy"]}
+		}
+
+		fields = append(fields, field)
+	}
+
+	output["fields"] = fields
+
+	return output
+}
+
+func (s *Server) schema(name string, implementation any) object {
+	for _, schema := range s.schemas[name] {
+		if schema["implementation"] == implementation {
+			return schema
+		}
+	}
+
+	return nil
+}
+
+func findField(fields []any, name any) object {
+	for _, f := range fields {
+		if field, ok := f.(object); ok && field["name"] == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+func mustDecode(path string, v any) {
+	content, err := data.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := json.Unmarshal(content, v); err != nil {
+		panic(err)
+	}
+}
+
+func readObject(w http.ResponseWriter, r *http.Request) (object, bool) {
+	var body object
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+
+		return nil, false
+	}
+
+	return body, true
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, object{"message": message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
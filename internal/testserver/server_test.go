@@ -0,0 +1,268 @@
+package testserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func request(t *testing.T, s *Server, method, path, key string, body any) (int, any) {
+	t.Helper()
+
+	var payload bytes.Buffer
+	if body != nil {
+		assert.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+
+	req, err := http.NewRequest(method, s.URL+path, &payload)
+	assert.NoError(t, err)
+	req.Header.Set("X-Api-Key", key)
+
+	resp, err := s.Client().Do(req)
+	assert.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	var output any
+
+	_ = json.NewDecoder(resp.Body).Decode(&output)
+
+	return resp.StatusCode, output
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	s := New("key")
+	t.Cleanup(s.Close)
+
+	tests := map[string]struct {
+		method   string
+		path     string
+		key      string
+		body     any
+		status   int
+		expected any
+	}{
+		"unauthorized": {
+			method: http.MethodGet, path: "/api/v1/tag", key: "error",
+			status: http.StatusUnauthorized, expected: map[string]any{"message": "Unauthorized"},
+		},
+		"unknown endpoint": {
+			method: http.MethodGet, path: "/api/v1/unknown", key: "key",
+			status: http.StatusNotFound, expected: map[string]any{"message": "NotFound"},
+		},
+		"missing object": {
+			method: http.MethodGet, path: "/api/v1/tag/99", key: "key",
+			status: http.StatusNotFound, expected: map[string]any{"message": "NotFound"},
+		},
+		"seeded object": {
+			method: http.MethodGet, path: "/api/v1/delayprofile/1", key: "key",
+			status: http.StatusOK,
+		},
+		"default config": {
+			method: http.MethodGet, path: "/api/v1/config/host", key: "key",
+			status: http.StatusOK, expected: map[string]any{"id": float64(1)},
+		},
+		"static": {
+			method: http.MethodGet, path: "/api/v1/system/status", key: "key",
+			status: http.StatusOK,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			status, output := request(t, s, test.method, test.path, test.key, test.body)
+			assert.Equal(t, test.status, status)
+
+			if test.expected != nil {
+				assert.Equal(t, test.expected, output)
+			}
+		})
+	}
+}
+
+func TestServerCRUD(t *testing.T) {
+	t.Parallel()
+
+	s := New("key")
+	t.Cleanup(s.Close)
+
+	status, created := request(t, s, http.MethodPost, "/api/v1/tag", "key", map[string]any{"label": "flac"})
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, map[string]any{"id": float64(1), "label": "flac"}, created)
+
+	status, updated := request(t, s, http.MethodPut, "/api/v1/tag/1", "key", map[string]any{"label": "mp3"})
+	assert.Equal(t, http.StatusAccepted, status)
+	assert.Equal(t, map[string]any{"id": float64(1), "label": "mp3"}, updated)
+
+	_, list := request(t, s, http.MethodGet, "/api/v1/tag", "key", nil)
+	assert.Equal(t, []any{map[string]any{"id": float64(1), "label": "mp3"}}, list)
+
+	status, _ = request(t, s, http.MethodDelete, "/api/v1/tag/1", "key", nil)
+	assert.Equal(t, http.StatusOK, status)
+
+	status, _ = request(t, s, http.MethodGet, "/api/v1/tag/1", "key", nil)
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestServerProviderFields(t *testing.T) {
+	t.Parallel()
+
+	s := New("key")
+	t.Cleanup(s.Close)
+
+	client := map[string]any{
+		"name":           "Sabnzbd",
+		"implementation": "Sabnzbd",
+		"configContract": "SabnzbdSettings",
+		"fields": []any{
+			map[string]any{"name": "host", "value": "sabnzbd"},
+			map[string]any{"name": "apiKey", "value": "secret"},
+		},
+	}
+
+	_, created := request(t, s, http.MethodPost, "/api/v1/downloadclient", "key", client)
+	fields := created.(map[string]any)["fields"].([]any)
+	// Missing fields are added with their defaults and secrets are masked.
+	assert.Contains(t, fields, map[string]any{"name": "host", "value": "sabnzbd"})
+	assert.Contains(t, fields, map[string]any{"name": "apiKey", "value": maskedValue, "privacy": "apiKey"})
+	assert.Contains(t, fields, map[string]any{"name": "port", "value": float64(0)})
+
+	// Masked secrets sent back keep the stored value.
+	client["fields"] = []any{
+		map[string]any{"name": "host", "value": "sabnzbd"},
+		map[string]any{"name": "apiKey", "value": maskedValue},
+	}
+	request(t, s, http.MethodPut, "/api/v1/downloadclient/1", "key", client)
+	assert.Contains(t, s.objects["downloadclient"][1]["fields"], map[string]any{"name": "apiKey", "value": "secret"})
+
+	// Masked secrets sent on creation are stored as is.
+	request(t, s, http.MethodPost, "/api/v1/downloadclient", "key", client)
+	assert.Contains(t, s.objects["downloadclient"][2]["fields"], map[string]any{"name": "apiKey", "value": maskedValue})
+
+	status, schema := request(t, s, http.MethodGet, "/api/v1/downloadclient/schema", "key", nil)
+	assert.Equal(t, http.StatusOK, status)
+	assert.NotEmpty(t, schema)
+}
+
+func TestServerProviderTest(t *testing.T) {
+	t.Parallel()
+
+	s := New("key")
+	t.Cleanup(s.Close)
+
+	indexer := map[string]any{
+		"implementation": "Torznab",
+		"fields": []any{
+			map[string]any{"name": "baseUrl", "value": "https://feed.torznab.com"},
+			map[string]any{"name": "seedCriteria.seedTime", "value": float64(10)},
+		},
+	}
+
+	status, _ := request(t, s, http.MethodPost, "/api/v1/indexer/test", "key", indexer)
+	assert.Equal(t, http.StatusOK, status)
+
+	indexer["fields"] = []any{
+		map[string]any{"name": "baseUrl", "value": "invalid"},
+		map[string]any{"name": "apiPath", "value": "warn"},
+	}
+
+	status, failures := request(t, s, http.MethodPost, "/api/v1/indexer/test", "key", indexer)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Len(t, failures, 2)
+	assert.Equal(t, "BaseUrl", failures.([]any)[0].(map[string]any)["propertyName"])
+	assert.Equal(t, true, failures.([]any)[1].(map[string]any)["isWarning"])
+	assert.Empty(t, s.objects["indexer"])
+}
+
+func TestServerProviderValidation(t *testing.T) {
+	t.Parallel()
+
+	s := New("key")
+	t.Cleanup(s.Close)
+
+	indexer := map[string]any{
+		"name":           "",
+		"implementation": "Torznab",
+		"configContract": "TorznabSettings",
+		"fields": []any{
+			map[string]any{"name": "baseUrl", "value": "https://feed.torznab.com"},
+			map[string]any{"name": "apiPath", "value": "warn"},
+		},
+	}
+
+	status, failures := request(t, s, http.MethodPost, "/api/v1/indexer", "key", indexer)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Len(t, failures, 2)
+	assert.Equal(t, "Name", failures.([]any)[0].(map[string]any)["propertyName"])
+	assert.Equal(t, "Fields[1].Value", failures.([]any)[1].(map[string]any)["propertyName"])
+
+	indexer["name"] = "Torznab"
+
+	status, failures = request(t, s, http.MethodPost, "/api/v1/indexer", "key", indexer)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, true, failures.([]any)[0].(map[string]any)["isWarning"])
+	assert.Empty(t, s.objects["indexer"])
+
+	// Warnings are ignored when forcing the save.
+	status, _ = request(t, s, http.MethodPost, "/api/v1/indexer?forceSave=true", "key", indexer)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Len(t, s.objects["indexer"], 1)
+}
+
+func TestServerCommand(t *testing.T) {
+	t.Parallel()
+
+	s := New("key")
+	t.Cleanup(s.Close)
+
+	status, command := request(t, s, http.MethodPost, "/api/v1/command", "key", map[string]any{"name": "RssSync", "artistIds": []any{1}})
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "queued", command.(map[string]any)["status"])
+	assert.Equal(t, []any{float64(1)}, command.(map[string]any)["body"].(map[string]any)["artistIds"])
+
+	status, command = request(t, s, http.MethodGet, "/api/v1/command/1", "key", nil)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "completed", command.(map[string]any)["status"])
+
+	request(t, s, http.MethodPost, "/api/v1/command", "key", map[string]any{"name": "InvalidCommand"})
+	_, command = request(t, s, http.MethodGet, "/api/v1/command/2", "key", nil)
+	assert.Equal(t, "failed", command.(map[string]any)["status"])
+
+	status, _ = request(t, s, http.MethodGet, "/api/v1/command/3", "key", nil)
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestServerIndexerSeedCriteria(t *testing.T) {
+	t.Parallel()
+
+	s := New("key")
+	t.Cleanup(s.Close)
+
+	indexer := map[string]any{
+		"name":           "FileList",
+		"implementation": "FileList",
+		"configContract": "FileListSettings",
+		"fields":         []any{},
+	}
+
+	_, created := request(t, s, http.MethodPost, "/api/v1/indexer", "key", indexer)
+	names := make([]any, 0)
+
+	for _, f := range created.(map[string]any)["fields"].([]any) {
+		names = append(names, f.(map[string]any)["name"])
+	}
+
+	// Seed settings are nested in the seed criteria, as in Lidarr.
+	assert.Contains(t, names, "seedCriteria.seedTime")
+	assert.Contains(t, names, "seedCriteria.seedRatio")
+	assert.NotContains(t, names, "seedTime")
+	assert.NotContains(t, names, "seedRatio")
+}
@@ -0,0 +1,518 @@
+// Command fieldgen generates the mapping between the attributes of a provider
+// data model and the generic fields of the Lidarr API, starting from a
+// declarative spec.
+//
+// It is meant to be run through go generate in the package defining the model:
+//
+//	//go:generate go run ../../tools/fieldgen -spec fieldspecs/indexer.json -out indexer_fields_gen.go
+//
+// The spec lists, for each attribute, its type and the API field names it is
+// mapped to. Generation fails if an attribute is missing from the model, if its
+// type does not match, or if a field name differs from the attribute name
+// without being explicitly marked as renamed. The generated code accesses the
+// model attributes directly, so any later divergence fails compilation.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Spec describes the fields of a data model.
+type Spec struct {
+	Model string `json:"model"`
+	// Schema enables the generation of the schema attributes of the generic resource and data sources.
+	Schema     bool        `json:"schema"`
+	Attributes []Attribute `json:"attributes"`
+}
+
+// Attribute describes a model attribute mapped to one or more API fields.
+type Attribute struct {
+	Attribute string `json:"attribute"`
+	Type      string `json:"type"`
+	// Fields are the API field names. All of them are read and written.
+	Fields []string `json:"fields"`
+	// Renamed allows field names not matching the attribute name.
+	Renamed     bool     `json:"renamed"`
+	Sensitive   bool     `json:"sensitive"`
+	Description string   `json:"description"`
+	Validators  []string `json:"validators"`
+
+	// GoName is the model struct field, resolved from its tfsdk tag.
+	GoName string `json:"-"`
+}
+
+// attributeType describes how a spec type is handled.
+type attributeType struct {
+	GoType     string
+	SchemaType string
+	Element    string
+	Write      string
+	Read       string
+	Validator  string
+}
+
+var attributeTypes = map[string]attributeType{
+	"bool":       {GoType: "types.Bool", SchemaType: "Bool", Write: "BoolFieldValue", Read: "AppendBoolField", Validator: "Bool"},
+	"int64":      {GoType: "types.Int64", SchemaType: "Int64", Write: "Int64FieldValue", Read: "AppendInt64Field", Validator: "Int64"},
+	"float64":    {GoType: "types.Float64", SchemaType: "Float64", Write: "Float64FieldValue", Read: "AppendFloat64Field", Validator: "Float64"},
+	"string":     {GoType: "types.String", SchemaType: "String", Write: "StringFieldValue", Read: "AppendStringField", Validator: "String"},
+	"string_set": {GoType: "types.Set", SchemaType: "Set", Element: "types.StringType", Write: "StringSetFieldValue", Read: "AppendStringSetField", Validator: "Set"},
+	"int64_set":  {GoType: "types.Set", SchemaType: "Set", Element: "types.Int64Type", Write: "Int64SetFieldValue", Read: "AppendInt64SetField", Validator: "Set"},
+}
+
+// validatorPackages are the packages validators can be imported from.
+var validatorPackages = map[string]string{
+	"boolvalidator":    "github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator",
+	"float64validator": "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+	"int64validator":   "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+	"setvalidator":     "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+	"stringvalidator":  "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+}
+
+func main() {
+	specPath := flag.String("spec", "", "path of the field spec")
+	output := flag.String("out", "", "path of the generated file")
+	flag.Parse()
+
+	if err := run(*specPath, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "fieldgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, output string) error {
+	if specPath == "" || output == "" {
+		return errors.New("both -spec and -out are required")
+	}
+
+	source, err := generateFile(".", specPath)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(output, source, 0o644)
+}
+
+// generateFile generates the code for the spec of a model defined in dir.
+// The spec path is relative to dir.
+func generateFile(dir, specPath string) ([]byte, error) {
+	content, err := os.ReadFile(filepath.Join(dir, specPath))
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Spec
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&spec); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", specPath, err)
+	}
+
+	pkg, model, err := parseModel(dir, spec.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(&spec, model); err != nil {
+		return nil, fmt.Errorf("%s: %w", specPath, err)
+	}
+
+	return generate(pkg, specPath, &spec)
+}
+
+// modelField is a struct field of the data model.
+type modelField struct {
+	name   string
+	goType string
+}
+
+// parseModel returns the package name and the fields of the model struct, indexed by tfsdk tag.
+func parseModel(dir, model string) (string, map[string]modelField, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fset := token.NewFileSet()
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if fields := findStruct(file, model); fields != nil {
+			return file.Name.Name, fields, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("model %s not found", model)
+}
+
+func findStruct(file *ast.File, model string) map[string]modelField {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, s := range gen.Specs {
+			typeSpec, _ := s.(*ast.TypeSpec)
+			structType, ok := typeSpec.Type.(*ast.StructType)
+
+			if !ok || typeSpec.Name.Name != model {
+				continue
+			}
+
+			fields := make(map[string]modelField)
+
+			for _, field := range structType.Fields.List {
+				if field.Tag == nil || len(field.Names) != 1 {
+					continue
+				}
+
+				tag, _ := strconv.Unquote(field.Tag.Value)
+				if tfsdk := reflect.StructTag(tag).Get("tfsdk"); tfsdk != "" {
+					fields[tfsdk] = modelField{name: field.Names[0].Name, goType: typeName(field.Type)}
+				}
+			}
+
+			return fields
+		}
+	}
+
+	return nil
+}
+
+func typeName(expr ast.Expr) string {
+	if selector, ok := expr.(*ast.SelectorExpr); ok {
+		if pkg, ok := selector.X.(*ast.Ident); ok {
+			return pkg.Name + "." + selector.Sel.Name
+		}
+	}
+
+	return fmt.Sprint(expr)
+}
+
+// validate checks the spec against the model and resolves the struct field names.
+func validate(spec *Spec, model map[string]modelField) error {
+	attributes := make(map[string]bool)
+	fields := make(map[string]bool)
+
+	for i := range spec.Attributes {
+		a := &spec.Attributes[i]
+
+		if attributes[a.Attribute] {
+			return fmt.Errorf("duplicate attribute %s", a.Attribute)
+		}
+
+		attributes[a.Attribute] = true
+
+		kind, ok := attributeTypes[a.Type]
+		if !ok {
+			return fmt.Errorf("attribute %s has unsupported type %s", a.Attribute, a.Type)
+		}
+
+		field, ok := model[a.Attribute]
+		if !ok {
+			return fmt.Errorf("attribute %s not found in model %s", a.Attribute, spec.Model)
+		}
+
+		if field.goType != kind.GoType {
+			return fmt.Errorf("attribute %s is %s in model %s, spec requires %s", a.Attribute, field.goType, spec.Model, kind.GoType)
+		}
+
+		a.GoName = field.name
+
+		if len(a.Fields) == 0 {
+			return fmt.Errorf("attribute %s is not mapped to any field", a.Attribute)
+		}
+
+		matching := true
+
+		for _, f := range a.Fields {
+			if fields[f] {
+				return fmt.Errorf("field %s is mapped more than once", f)
+			}
+
+			fields[f] = true
+			matching = matching && normalize(f) == normalize(a.Attribute)
+		}
+
+		if matching == a.Renamed {
+			if a.Renamed {
+				return fmt.Errorf("attribute %s is marked as renamed, but its fields match", a.Attribute)
+			}
+
+			return fmt.Errorf("fields %v do not match attribute %s, mark it as renamed if intended", a.Fields, a.Attribute)
+		}
+
+		if a.Sensitive && a.Type != "string" {
+			return fmt.Errorf("attribute %s is sensitive, only strings are supported", a.Attribute)
+		}
+
+		if !spec.Schema && (a.Description != "" || len(a.Validators) != 0) {
+			return fmt.Errorf("attribute %s defines schema details, but schema generation is disabled", a.Attribute)
+		}
+
+		if spec.Schema && a.Description == "" {
+			return fmt.Errorf("attribute %s has no description", a.Attribute)
+		}
+
+		for _, v := range a.Validators {
+			if _, ok := validatorPackages[strings.Split(v, ".")[0]]; !ok {
+				return fmt.Errorf("attribute %s has validator %s from an unsupported package", a.Attribute, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// normalize makes API and attribute names comparable.
+func normalize(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// generate renders the spec into formatted Go source.
+func generate(pkg, specPath string, spec *Spec) ([]byte, error) {
+	imports := []string{
+		"context",
+		"github.com/devopsarr/lidarr-go/lidarr",
+		"github.com/devopsarr/terraform-provider-lidarr/internal/helpers",
+		"github.com/hashicorp/terraform-plugin-framework/diag",
+	}
+
+	sets, sensitive := false, false
+
+	for _, a := range spec.Attributes {
+		sets = sets || attributeTypes[a.Type].Element != ""
+		sensitive = sensitive || a.Sensitive
+
+		if spec.Schema {
+			for _, v := range a.Validators {
+				imports = append(imports, validatorPackages[strings.Split(v, ".")[0]], "github.com/hashicorp/terraform-plugin-framework/schema/validator")
+			}
+		}
+	}
+
+	if spec.Schema {
+		imports = append(imports,
+			"github.com/hashicorp/terraform-plugin-framework/resource/schema",
+			"dataSourceSchema github.com/hashicorp/terraform-plugin-framework/datasource/schema")
+
+		if sets {
+			imports = append(imports, "github.com/hashicorp/terraform-plugin-framework/types")
+		}
+	}
+
+	var buf bytes.Buffer
+
+	err := fileTemplate.Execute(&buf, map[string]any{
+		"Package":   pkg,
+		"Spec":      spec,
+		"SpecPath":  specPath,
+		"Imports":   sortImports(imports),
+		"Receiver":  strings.ToLower(spec.Model[:1]),
+		"Sets":      sets,
+		"Sensitive": sensitive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format generated code: %w\n%s", err, buf.String())
+	}
+
+	return source, nil
+}
+
+// sortImports removes duplicates and sorts the imports, standard library first.
+func sortImports(imports []string) [][]string {
+	unique := make(map[string]bool)
+	groups := [][]string{nil, nil}
+
+	for _, i := range imports {
+		if unique[i] {
+			continue
+		}
+
+		unique[i] = true
+
+		path := i[strings.LastIndex(i, " ")+1:]
+		if strings.Contains(path, ".") {
+			groups[1] = append(groups[1], i)
+		} else {
+			groups[0] = append(groups[0], i)
+		}
+	}
+
+	for _, g := range groups {
+		sort.Slice(g, func(a, b int) bool {
+			return g[a][strings.LastIndex(g[a], " ")+1:] < g[b][strings.LastIndex(g[b], " ")+1:]
+		})
+	}
+
+	return groups
+}
+
+func importLine(i string) string {
+	if alias, path, ok := strings.Cut(i, " "); ok {
+		return alias + " " + strconv.Quote(path)
+	}
+
+	return strconv.Quote(i)
+}
+
+var fileTemplate = template.Must(template.New("fields").Funcs(template.FuncMap{
+	"kind":  func(t string) attributeType { return attributeTypes[t] },
+	"quote": strconv.Quote,
+	"quoteAll": func(s []string) string {
+		quoted := make([]string, len(s))
+		for i, v := range s {
+			quoted[i] = strconv.Quote(v)
+		}
+
+		return strings.Join(quoted, ", ")
+	},
+	"importLine": importLine,
+	"lowerFirst": func(s string) string { return strings.ToLower(s[:1]) + s[1:] },
+}).Parse(`// Code generated by fieldgen from {{.SpecPath}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+{{range .}}	{{importLine .}}
+{{end}}
+{{- end}}
+)
+{{$r := .Receiver}}{{$model := .Spec.Model}}
+// writeFields populates the {{$model}} attributes from the API fields.
+func ({{$r}} *{{$model}}) writeFields(fields []lidarr.Field, diags *diag.Diagnostics) {
+	for _, field := range fields {
+		var err error
+
+		switch field.GetName() {
+{{- range .Spec.Attributes}}
+		case {{quoteAll .Fields}}:
+{{- if .Sensitive}}
+			{{$r}}.{{.GoName}}, err = helpers.SensitiveFieldValue(&field, {{$r}}.{{.GoName}})
+{{- else if eq .Type "string"}}
+			{{$r}}.{{.GoName}}, err = helpers.StringFieldValue(&field, {{$r}}.{{.GoName}})
+{{- else}}
+			{{$r}}.{{.GoName}}, err = helpers.{{(kind .Type).Write}}(&field)
+{{- end}}
+{{- end}}
+		}
+
+		if err != nil {
+			diags.AddError(helpers.FieldError, helpers.ParseFieldError(field.GetName(), err))
+		}
+	}
+}
+
+// readFields returns the API fields of the {{$model}} attributes.
+func ({{$r}} *{{$model}}) readFields({{if .Sets}}ctx{{else}}_{{end}} context.Context, {{if .Sets}}diags{{else}}_{{end}} *diag.Diagnostics) []lidarr.Field {
+	var fields []lidarr.Field
+{{range $a := .Spec.Attributes}}{{range .Fields}}
+{{- if $a.Sensitive}}
+	fields = helpers.AppendSensitiveField(fields, {{quote .}}, {{$r}}.{{$a.GoName}})
+{{- else if (kind $a.Type).Element}}
+	fields = helpers.{{(kind $a.Type).Read}}(ctx, fields, {{quote .}}, {{$r}}.{{$a.GoName}}, diags)
+{{- else}}
+	fields = helpers.{{(kind $a.Type).Read}}(fields, {{quote .}}, {{$r}}.{{$a.GoName}})
+{{- end}}
+{{- end}}{{end}}
+
+	return fields
+}
+{{- if .Sensitive}}
+
+// maskFields appends the masked value of the secrets set in the prior state but not in the plan, so that the API keeps them.
+func ({{$r}} *{{$model}}) maskFields(fields []lidarr.Field, prior *{{$model}}) []lidarr.Field {
+{{- range $a := .Spec.Attributes}}{{if $a.Sensitive}}{{range .Fields}}
+	fields = helpers.AppendMaskedField(fields, {{quote .}}, {{$r}}.{{$a.GoName}}, prior.{{$a.GoName}})
+{{- end}}{{end}}{{end}}
+
+	return fields
+}
+{{- end}}
+{{- if .Spec.Schema}}
+
+// with{{$model}}FieldAttributes adds the field attributes to the {{$model}} resource schema.
+func with{{$model}}FieldAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+{{- range .Spec.Attributes}}
+	attributes[{{quote .Attribute}}] = schema.{{(kind .Type).SchemaType}}Attribute{
+		MarkdownDescription: {{quote .Description}},
+		Optional:            true,
+		Computed:            true,
+{{- if .Sensitive}}
+		Sensitive: true,
+{{- end}}
+{{- if (kind .Type).Element}}
+		ElementType: {{(kind .Type).Element}},
+{{- end}}
+{{- if .Validators}}
+		Validators: []validator.{{(kind .Type).Validator}}{
+{{- range .Validators}}
+			{{.}},
+{{- end}}
+		},
+{{- end}}
+	}
+{{- end}}
+
+	return attributes
+}
+
+// with{{$model}}FieldDataSourceAttributes adds the field attributes to the {{$model}} data source schemas.
+func with{{$model}}FieldDataSourceAttributes(attributes map[string]dataSourceSchema.Attribute) map[string]dataSourceSchema.Attribute {
+{{- range .Spec.Attributes}}
+	attributes[{{quote .Attribute}}] = dataSourceSchema.{{(kind .Type).SchemaType}}Attribute{
+		MarkdownDescription: {{quote .Description}},
+		Computed:            true,
+{{- if .Sensitive}}
+		Sensitive: true,
+{{- end}}
+{{- if (kind .Type).Element}}
+		ElementType: {{(kind .Type).Element}},
+{{- end}}
+	}
+{{- end}}
+
+	return attributes
+}
+
+// {{lowerFirst $model}}FieldNames maps the API field names to the {{$model}} attributes managing them.
+var {{lowerFirst $model}}FieldNames = map[string]string{
+{{- range $a := .Spec.Attributes}}{{range .Fields}}
+	{{quote .}}: {{quote $a.Attribute}},
+{{- end}}{{end}}
+}
+{{- end}}
+`))
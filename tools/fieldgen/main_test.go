@@ -0,0 +1,132 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const providerDir = "../../internal/provider"
+
+// TestGeneratedFiles checks that the committed code matches the specs.
+func TestGeneratedFiles(t *testing.T) {
+	t.Parallel()
+
+	specs, err := filepath.Glob(filepath.Join(providerDir, "fieldspecs", "*.json"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, specs)
+
+	for _, spec := range specs {
+		spec := spec
+		name := strings.TrimSuffix(filepath.Base(spec), ".json")
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			generated, err := generateFile(providerDir, filepath.Join("fieldspecs", name+".json"))
+			assert.NoError(t, err)
+
+			committed, err := os.ReadFile(filepath.Join(providerDir, name+"_fields_gen.go"))
+			assert.NoError(t, err)
+			assert.Equal(t, string(committed), string(generated), "run go generate ./...")
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	model := map[string]modelField{
+		"api_key":    {name: "APIKey", goType: "types.String"},
+		"field_tags": {name: "FieldTags", goType: "types.Set"},
+		"port":       {name: "Port", goType: "types.Int64"},
+	}
+
+	tests := map[string]struct {
+		spec     Spec
+		expected string
+	}{
+		"valid": {
+			spec: Spec{Attributes: []Attribute{
+				{Attribute: "api_key", Type: "string", Fields: []string{"apiKey", "aPIKey"}, Sensitive: true},
+				{Attribute: "field_tags", Type: "string_set", Fields: []string{"tags"}, Renamed: true},
+			}},
+		},
+		"missing attribute": {
+			spec:     Spec{Model: "Test", Attributes: []Attribute{{Attribute: "host", Type: "string", Fields: []string{"host"}}}},
+			expected: "attribute host not found in model Test",
+		},
+		"wrong type": {
+			spec:     Spec{Model: "Test", Attributes: []Attribute{{Attribute: "port", Type: "string", Fields: []string{"port"}}}},
+			expected: "attribute port is types.Int64 in model Test, spec requires types.String",
+		},
+		"unsupported type": {
+			spec:     Spec{Attributes: []Attribute{{Attribute: "port", Type: "int32", Fields: []string{"port"}}}},
+			expected: "attribute port has unsupported type int32",
+		},
+		"diverging field": {
+			spec:     Spec{Attributes: []Attribute{{Attribute: "port", Type: "int64", Fields: []string{"prot"}}}},
+			expected: "fields [prot] do not match attribute port, mark it as renamed if intended",
+		},
+		"useless rename": {
+			spec:     Spec{Attributes: []Attribute{{Attribute: "port", Type: "int64", Fields: []string{"port"}, Renamed: true}}},
+			expected: "attribute port is marked as renamed, but its fields match",
+		},
+		"duplicate attribute": {
+			spec: Spec{Attributes: []Attribute{
+				{Attribute: "port", Type: "int64", Fields: []string{"port"}},
+				{Attribute: "port", Type: "int64", Fields: []string{"Port"}},
+			}},
+			expected: "duplicate attribute port",
+		},
+		"duplicate field": {
+			spec: Spec{Attributes: []Attribute{
+				{Attribute: "api_key", Type: "string", Fields: []string{"apiKey"}},
+				{Attribute: "field_tags", Type: "string_set", Fields: []string{"apiKey"}, Renamed: true},
+			}},
+			expected: "field apiKey is mapped more than once",
+		},
+		"no field": {
+			spec:     Spec{Attributes: []Attribute{{Attribute: "port", Type: "int64"}}},
+			expected: "attribute port is not mapped to any field",
+		},
+		"sensitive int": {
+			spec:     Spec{Attributes: []Attribute{{Attribute: "port", Type: "int64", Fields: []string{"port"}, Sensitive: true}}},
+			expected: "attribute port is sensitive, only strings are supported",
+		},
+		"missing description": {
+			spec:     Spec{Schema: true, Attributes: []Attribute{{Attribute: "port", Type: "int64", Fields: []string{"port"}}}},
+			expected: "attribute port has no description",
+		},
+		"description without schema": {
+			spec:     Spec{Attributes: []Attribute{{Attribute: "port", Type: "int64", Fields: []string{"port"}, Description: "Port."}}},
+			expected: "attribute port defines schema details, but schema generation is disabled",
+		},
+		"unsupported validator": {
+			spec: Spec{Schema: true, Attributes: []Attribute{
+				{Attribute: "port", Type: "int64", Fields: []string{"port"}, Description: "Port.", Validators: []string{"custom.Port()"}},
+			}},
+			expected: "attribute port has validator custom.Port() from an unsupported package",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validate(&test.spec, model)
+			if test.expected == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, "APIKey", test.spec.Attributes[0].GoName)
+
+				return
+			}
+
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}